@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"time"
+	// embed time zone database, as the container image doesn't provide it for quiet hours time zones
+	_ "time/tzdata"
 
 	"github.com/google/go-github/v44/github"
 	"github.com/gorilla/mux"
@@ -39,9 +41,12 @@ import (
 	"github.com/kubeshop/botkube/pkg/filterengine"
 	"github.com/kubeshop/botkube/pkg/httpsrv"
 	"github.com/kubeshop/botkube/pkg/notifier"
+	"github.com/kubeshop/botkube/pkg/podlogs"
 	"github.com/kubeshop/botkube/pkg/recommendation"
+	"github.com/kubeshop/botkube/pkg/schedule"
 	"github.com/kubeshop/botkube/pkg/sink"
 	"github.com/kubeshop/botkube/pkg/sources"
+	"github.com/kubeshop/botkube/pkg/workload"
 )
 
 const (
@@ -143,6 +148,8 @@ func run() error {
 		resourceNameNormalizerFunc = resourceNameNormalizer.Normalize
 	}
 
+	recommFactory := recommendation.NewFactory(logger.WithField(componentLogFieldKey, "Recommendations"), dynamicCli)
+
 	// Create executor factor
 	cfgManager := config.NewManager(logger.WithField(componentLogFieldKey, "Config manager"), conf.Settings.PersistentConfig, k8sCli)
 	executorFactory := execute.NewExecutorFactory(
@@ -155,15 +162,28 @@ func run() error {
 			Merger:            kcMerger,
 			CfgManager:        cfgManager,
 			AnalyticsReporter: reporter,
+			RecommFactory:     recommFactory,
+			DynamicCli:        dynamicCli,
+			Mapper:            restmapper.NewShortcutExpander(mapper, discoveryCli),
 		},
 	)
 
-	router := sources.NewRouter(mapper, dynamicCli, logger.WithField(componentLogFieldKey, "Router"))
+	// Action buttons attached to event notifications
+	eventActions := bot.NewEventActions(kcMerger, kubectl.NewChecker(resourceNameNormalizerFunc))
+
+	// Top-level owners are cached, so the resolver is shared by the router and the controller
+	workloadResolver := workload.NewResolver(dynamicCli, mapper)
+
+	router := sources.NewRouter(mapper, dynamicCli, logger.WithField(componentLogFieldKey, "Router")).
+		WithEventsResource(conf.Settings.EventsResource).
+		WithWorkloadResolver(workloadResolver)
 
 	commCfg := conf.Communications
 	var (
 		notifiers []notifier.Notifier
 		bots      = map[string]bot.Bot{}
+		// bindingsUpdaters are indexed by the communication group name.
+		bindingsUpdaters = map[string][]bot.BindingsUpdater{}
 	)
 
 	// TODO: Current limitation: Communication platform config should be separate inside every group:
@@ -178,6 +198,9 @@ func run() error {
 		scheduleBot := func(in bot.Bot) {
 			notifiers = append(notifiers, in)
 			bots[fmt.Sprintf("%s-%s", commGroupName, in.IntegrationName())] = in
+			if updater, ok := in.(bot.BindingsUpdater); ok {
+				bindingsUpdaters[commGroupName] = append(bindingsUpdaters[commGroupName], updater)
+			}
 			errGroup.Go(func() error {
 				defer analytics.ReportPanicIfOccurs(commGroupLogger, reporter)
 				return in.Start(ctx)
@@ -194,7 +217,7 @@ func run() error {
 		}
 
 		if commGroupCfg.SocketSlack.Enabled {
-			sb, err := bot.NewSocketSlack(commGroupLogger.WithField(botLogFieldKey, "SocketSlack"), commGroupName, commGroupCfg.SocketSlack, executorFactory, reporter)
+			sb, err := bot.NewSocketSlack(commGroupLogger.WithField(botLogFieldKey, "SocketSlack"), commGroupName, commGroupCfg.SocketSlack, conf.Settings.ClusterName, executorFactory, eventActions, reporter)
 			if err != nil {
 				return reportFatalError("while creating SocketSlack bot", err)
 			}
@@ -202,7 +225,7 @@ func run() error {
 		}
 
 		if commGroupCfg.Mattermost.Enabled {
-			mb, err := bot.NewMattermost(commGroupLogger.WithField(botLogFieldKey, "Mattermost"), commGroupName, commGroupCfg.Mattermost, executorFactory, reporter)
+			mb, err := bot.NewMattermost(commGroupLogger.WithField(botLogFieldKey, "Mattermost"), commGroupName, commGroupCfg.Mattermost, executorFactory, eventActions, reporter)
 			if err != nil {
 				return reportFatalError("while creating Mattermost bot", err)
 			}
@@ -210,7 +233,7 @@ func run() error {
 		}
 
 		if commGroupCfg.Teams.Enabled {
-			tb, err := bot.NewTeams(commGroupLogger.WithField(botLogFieldKey, "MS Teams"), commGroupName, commGroupCfg.Teams, conf.Settings.ClusterName, executorFactory, reporter)
+			tb, err := bot.NewTeams(commGroupLogger.WithField(botLogFieldKey, "MS Teams"), commGroupName, commGroupCfg.Teams, conf.Settings.ClusterName, executorFactory, cfgManager, reporter)
 			if err != nil {
 				return reportFatalError("while creating Teams bot", err)
 			}
@@ -218,7 +241,7 @@ func run() error {
 		}
 
 		if commGroupCfg.Discord.Enabled {
-			db, err := bot.NewDiscord(commGroupLogger.WithField(botLogFieldKey, "Discord"), commGroupName, commGroupCfg.Discord, executorFactory, reporter)
+			db, err := bot.NewDiscord(commGroupLogger.WithField(botLogFieldKey, "Discord"), commGroupName, commGroupCfg.Discord, executorFactory, kcMerger, eventActions, reporter)
 			if err != nil {
 				return reportFatalError("while creating Discord bot", err)
 			}
@@ -244,23 +267,6 @@ func run() error {
 		}
 	}
 
-	// Lifecycle server
-	if conf.Settings.LifecycleServer.Enabled {
-		lifecycleSrv := lifecycle.NewServer(
-			logger.WithField(componentLogFieldKey, "Lifecycle server"),
-			k8sCli,
-			conf.Settings.LifecycleServer,
-			conf.Settings.ClusterName,
-			func(msg string) error {
-				return notifier.SendPlaintextMessage(ctx, notifiers, msg)
-			},
-		)
-		errGroup.Go(func() error {
-			defer analytics.ReportPanicIfOccurs(logger, reporter)
-			return lifecycleSrv.Serve(ctx)
-		})
-	}
-
 	if conf.ConfigWatcher.Enabled {
 		err := config.WaitForWatcherSync(
 			ctx,
@@ -300,22 +306,77 @@ func run() error {
 		})
 	}
 
-	recommFactory := recommendation.NewFactory(logger.WithField(componentLogFieldKey, "Recommendations"), dynamicCli)
+	// Start scheduler
+	var scheduledBots []schedule.Bot
+	for _, b := range bots {
+		scheduledBots = append(scheduledBots, b)
+	}
+	scheduler := schedule.NewScheduler(
+		logger.WithField(componentLogFieldKey, "Scheduler"),
+		*conf,
+		execute.NewKubectl(
+			logger.WithField(componentLogFieldKey, "Scheduled Kubectl Executor"),
+			*conf,
+			kcMerger,
+			kubectl.NewChecker(resourceNameNormalizerFunc),
+			&execute.OSCommand{},
+		),
+		scheduledBots,
+	)
+	errGroup.Go(func() error {
+		defer analytics.ReportPanicIfOccurs(logger, reporter)
+		return scheduler.Start(ctx)
+	})
 
 	// Create and start controller
 	ctrl := controller.New(
 		logger.WithField(componentLogFieldKey, "Controller"),
 		conf,
 		notifiers,
+		scheduler,
 		recommFactory,
+		podlogs.NewFetcher(logger.WithField(componentLogFieldKey, "Pod Logs Fetcher"), k8sCli),
 		filterEngine,
 		dynamicCli,
 		mapper,
+		workloadResolver,
 		conf.Settings.InformersResyncPeriod,
 		router.BuildTable(conf),
 		reporter,
 	)
 
+	// Lifecycle server
+	if conf.Settings.LifecycleServer.Enabled {
+		lifecycleSrv := lifecycle.NewServer(
+			logger.WithField(componentLogFieldKey, "Lifecycle server"),
+			k8sCli,
+			conf.Settings.LifecycleServer,
+			conf.Settings.ClusterName,
+			func(msg string) error {
+				return notifier.SendPlaintextMessage(ctx, notifiers, msg)
+			},
+			lifecycle.NewConfigReloader(
+				logger.WithField(componentLogFieldKey, "Config Reloader"),
+				conf,
+				func() (*config.Config, error) {
+					cfgPaths := config.FromEnvOrFlag
+					if conf.ConfigWatcher.Enabled {
+						cfgPaths = config.WithWatcherSyncedFiles(conf.ConfigWatcher.TmpDir, cfgPaths)
+					}
+					cfg, _, err := config.LoadWithDefaults(cfgPaths)
+					return cfg, err
+				},
+				ctrl,
+				executorFactory,
+				bindingsUpdaters,
+			),
+		)
+		errGroup.Go(func() error {
+			defer analytics.ReportPanicIfOccurs(logger, reporter)
+			return lifecycleSrv.Serve(ctx)
+		})
+	}
+
 	err = ctrl.Start(ctx)
 	if err != nil {
 		return reportFatalError("while starting controller", err)
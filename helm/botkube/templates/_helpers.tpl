@@ -50,6 +50,14 @@ Create the name of the service account to use
 {{- .Values.ssl.existingSecretName | default (printf "%s-certificate-secret" (include "botkube.fullname" .)) -}}
 {{- end -}}
 
+{{- define "botkube.communication.mattermost.interactivity.enabled" -}}
+{{- range $key, $val := .Values.communications -}}
+{{- if and $val.mattermost.enabled $val.mattermost.interactivity.enabled -}}
+  {{- true -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
 {{- define "botkube.communication.team.enabled" -}}
 {{- range $key, $val := .Values.communications -}}
 {{- if $val.teams.enabled -}}
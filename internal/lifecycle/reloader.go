@@ -0,0 +1,221 @@
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/bot"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/controller"
+)
+
+const reloadedMsgFmt = ":arrows_counterclockwise: Configuration reloaded for cluster '%s' without restart."
+
+// ErrRestartRequired is returned when the configuration changed in a way which cannot be applied without restart.
+var ErrRestartRequired = errors.New("configuration change requires restart")
+
+// LoadConfigFn defines a function which loads the current configuration.
+type LoadConfigFn func() (*config.Config, error)
+
+// SourcesReloader applies the sources configuration without restart.
+type SourcesReloader interface {
+	Reload(cfg *config.Config) (controller.ReloadSummary, error)
+}
+
+// ExecutorConfigSetter sets the configuration used by executors.
+type ExecutorConfigSetter interface {
+	SetConfig(cfg config.Config)
+}
+
+// ConfigReloader applies changes of sources and channel bindings without restart.
+type ConfigReloader struct {
+	log       logrus.FieldLogger
+	loadFn    LoadConfigFn
+	sources   SourcesReloader
+	executors ExecutorConfigSetter
+	// bots are indexed by the communication group name.
+	bots map[string][]bot.BindingsUpdater
+
+	mu  sync.Mutex
+	cfg *config.Config
+}
+
+// NewConfigReloader returns a new ConfigReloader instance.
+func NewConfigReloader(log logrus.FieldLogger, cfg *config.Config, loadFn LoadConfigFn, sources SourcesReloader, executors ExecutorConfigSetter, bots map[string][]bot.BindingsUpdater) *ConfigReloader {
+	return &ConfigReloader{
+		log:       log,
+		cfg:       cfg,
+		loadFn:    loadFn,
+		sources:   sources,
+		executors: executors,
+		bots:      bots,
+	}
+}
+
+// Reload loads the configuration and applies it if only sources and channel bindings changed.
+// It returns a message which summarizes the applied changes, or ErrRestartRequired if other settings changed
+// or the loaded configuration is the same as the current one.
+func (r *ConfigReloader) Reload() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	newCfg, err := r.loadFn()
+	if err != nil {
+		return "", fmt.Errorf("while loading configuration: %w", err)
+	}
+
+	if reflect.DeepEqual(r.cfg, newCfg) {
+		// The reload was requested, but the loaded files may not be updated yet, e.g. mounted ConfigMaps are synced by kubelet with a delay.
+		// Restart to make sure the requested changes are applied.
+		r.log.Info("Loaded configuration doesn't differ from the current one.")
+		return "", ErrRestartRequired
+	}
+
+	if !reloadableWithoutRestart(r.cfg, newCfg) {
+		return "", ErrRestartRequired
+	}
+
+	r.log.Info("Applying configuration without restart...")
+	summary, err := r.sources.Reload(newCfg)
+	if err != nil {
+		return "", fmt.Errorf("while reloading sources: %w", err)
+	}
+
+	r.executors.SetConfig(*newCfg)
+	for commGroupName, bots := range r.bots {
+		for _, b := range bots {
+			b.SetBindings(newCfg.Communications[commGroupName])
+		}
+	}
+
+	msg := reloadSummaryMsg(r.cfg, newCfg, summary)
+	r.cfg = newCfg
+	return msg, nil
+}
+
+// reloadableWithoutRestart returns true if the configurations differ only in sources and channel bindings.
+func reloadableWithoutRestart(oldCfg, newCfg *config.Config) bool {
+	return reflect.DeepEqual(withoutReloadableFields(*oldCfg), withoutReloadableFields(*newCfg))
+}
+
+func withoutReloadableFields(cfg config.Config) config.Config {
+	cfg.Sources = nil
+
+	comms := make(map[string]config.Communications, len(cfg.Communications))
+	for name, commGroup := range cfg.Communications {
+		commGroup.Slack.Channels = channelsByNameWithoutBindings(commGroup.Slack.Channels)
+		commGroup.SocketSlack.Channels = channelsByNameWithoutBindings(commGroup.SocketSlack.Channels)
+		commGroup.Mattermost.Channels = channelsByNameWithoutBindings(commGroup.Mattermost.Channels)
+		commGroup.Teams.Channels = channelsByNameWithoutBindings(commGroup.Teams.Channels)
+		commGroup.Teams.Bindings = config.BotBindings{}
+
+		discordChannels := make(config.IdentifiableMap[config.ChannelBindingsByID], len(commGroup.Discord.Channels))
+		for alias, channel := range commGroup.Discord.Channels {
+			channel.Bindings = config.BotBindings{}
+			discordChannels[alias] = channel
+		}
+		commGroup.Discord.Channels = discordChannels
+
+		comms[name] = commGroup
+	}
+	cfg.Communications = comms
+
+	return cfg
+}
+
+func channelsByNameWithoutBindings(in config.IdentifiableMap[config.ChannelBindingsByName]) config.IdentifiableMap[config.ChannelBindingsByName] {
+	out := make(config.IdentifiableMap[config.ChannelBindingsByName], len(in))
+	for alias, channel := range in {
+		channel.Bindings = config.BotBindings{}
+		out[alias] = channel
+	}
+	return out
+}
+
+// reloadSummaryMsg returns a message which describes the changes applied during the configuration reload.
+func reloadSummaryMsg(oldCfg, newCfg *config.Config, summary controller.ReloadSummary) string {
+	var added, removed, changed []string
+	for name, src := range newCfg.Sources {
+		oldSrc, exists := oldCfg.Sources[name]
+		switch {
+		case !exists:
+			added = append(added, name)
+		case !reflect.DeepEqual(oldSrc, src):
+			changed = append(changed, name)
+		}
+	}
+	for name := range oldCfg.Sources {
+		if _, exists := newCfg.Sources[name]; !exists {
+			removed = append(removed, name)
+		}
+	}
+
+	var out strings.Builder
+	out.WriteString(fmt.Sprintf(reloadedMsgFmt, newCfg.Settings.ClusterName))
+
+	changes := []struct {
+		title string
+		items []string
+	}{
+		{title: "Added sources", items: added},
+		{title: "Removed sources", items: removed},
+		{title: "Changed sources", items: changed},
+		{title: "Started watching", items: summary.StartedResources},
+		{title: "Stopped watching", items: summary.StoppedResources},
+		{title: "Updated channel bindings", items: changedBindings(oldCfg, newCfg)},
+	}
+
+	noChanges := true
+	for _, change := range changes {
+		if len(change.items) == 0 {
+			continue
+		}
+		noChanges = false
+		sort.Strings(change.items)
+		out.WriteString(fmt.Sprintf("\n%s: %s", change.title, strings.Join(change.items, ", ")))
+	}
+	if noChanges {
+		out.WriteString("\nNo changes detected.")
+	}
+
+	return out.String()
+}
+
+// changedBindings returns channels with changed bindings in the `<group>/<platform>/<alias>` format.
+func changedBindings(oldCfg, newCfg *config.Config) []string {
+	var out []string
+	for groupName, newGroup := range newCfg.Communications {
+		oldGroup := oldCfg.Communications[groupName]
+
+		out = append(out, changedBindingsByName(groupName, config.SlackCommPlatformIntegration, oldGroup.Slack.Channels, newGroup.Slack.Channels)...)
+		out = append(out, changedBindingsByName(groupName, config.SocketSlackCommPlatformIntegration, oldGroup.SocketSlack.Channels, newGroup.SocketSlack.Channels)...)
+		out = append(out, changedBindingsByName(groupName, config.MattermostCommPlatformIntegration, oldGroup.Mattermost.Channels, newGroup.Mattermost.Channels)...)
+		out = append(out, changedBindingsByName(groupName, config.TeamsCommPlatformIntegration, oldGroup.Teams.Channels, newGroup.Teams.Channels)...)
+		if !reflect.DeepEqual(oldGroup.Teams.Bindings, newGroup.Teams.Bindings) {
+			out = append(out, fmt.Sprintf("%s/%s/default", groupName, config.TeamsCommPlatformIntegration))
+		}
+
+		for alias, channel := range newGroup.Discord.Channels {
+			if !reflect.DeepEqual(oldGroup.Discord.Channels[alias].Bindings, channel.Bindings) {
+				out = append(out, fmt.Sprintf("%s/%s/%s", groupName, config.DiscordCommPlatformIntegration, alias))
+			}
+		}
+	}
+	return out
+}
+
+func changedBindingsByName(groupName string, platform config.CommPlatformIntegration, oldChannels, newChannels config.IdentifiableMap[config.ChannelBindingsByName]) []string {
+	var out []string
+	for alias, channel := range newChannels {
+		if !reflect.DeepEqual(oldChannels[alias].Bindings, channel.Bindings) {
+			out = append(out, fmt.Sprintf("%s/%s/%s", groupName, platform, alias))
+		}
+	}
+	return out
+}
@@ -0,0 +1,142 @@
+package lifecycle
+
+import (
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/controller"
+)
+
+func TestConfigReloader_Reload(t *testing.T) {
+	// given
+	oldCfg := fixReloaderConfig([]string{"k8s-events"})
+	oldCfg.Sources = map[string]config.Sources{
+		"k8s-events": {Kubernetes: config.KubernetesSource{Namespaces: config.Namespaces{Include: []string{".*"}}}},
+		"k8s-old":    {},
+	}
+
+	newCfg := fixReloaderConfig([]string{"k8s-events", "k8s-certs"})
+	newCfg.Sources = map[string]config.Sources{
+		"k8s-events": {Kubernetes: config.KubernetesSource{Namespaces: config.Namespaces{Include: []string{"team-a"}}}},
+		"k8s-certs":  {},
+	}
+
+	sources := &fakeSourcesReloader{summary: controller.ReloadSummary{
+		StartedResources: []string{"cert-manager.io/v1/certificates"},
+		StoppedResources: []string{"v1/pods"},
+	}}
+	executors := &fakeExecutorConfigSetter{}
+	slackBot := &fakeBindingsUpdater{}
+
+	logger, _ := logtest.NewNullLogger()
+	reloader := NewConfigReloader(logger, oldCfg, func() (*config.Config, error) {
+		return newCfg, nil
+	}, sources, executors, map[string][]bot.BindingsUpdater{"default-group": {slackBot}})
+
+	expectedMsg := ":arrows_counterclockwise: Configuration reloaded for cluster 'foo' without restart.\n" +
+		"Added sources: k8s-certs\n" +
+		"Removed sources: k8s-old\n" +
+		"Changed sources: k8s-events\n" +
+		"Started watching: cert-manager.io/v1/certificates\n" +
+		"Stopped watching: v1/pods\n" +
+		"Updated channel bindings: default-group/slack/default"
+
+	// when
+	msg, err := reloader.Reload()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, expectedMsg, msg)
+	assert.Equal(t, newCfg, sources.cfg)
+	assert.Equal(t, *newCfg, executors.cfg)
+	assert.Equal(t, newCfg.Communications["default-group"], slackBot.commGroup)
+}
+
+func TestConfigReloader_ReloadUnchangedConfigRequiresRestart(t *testing.T) {
+	// given
+	cfg := fixReloaderConfig([]string{"k8s-events"})
+	sources := &fakeSourcesReloader{}
+	logger, _ := logtest.NewNullLogger()
+	reloader := NewConfigReloader(logger, cfg, func() (*config.Config, error) {
+		// e.g. mounted files which are not updated yet
+		return fixReloaderConfig([]string{"k8s-events"}), nil
+	}, sources, &fakeExecutorConfigSetter{}, nil)
+
+	// when
+	_, err := reloader.Reload()
+
+	// then
+	assert.ErrorIs(t, err, ErrRestartRequired)
+	assert.Nil(t, sources.cfg)
+}
+
+func TestConfigReloader_ReloadRequiresRestart(t *testing.T) {
+	// given
+	newCfg := fixReloaderConfig([]string{"k8s-events"})
+	newCfg.Communications["default-group"] = config.Communications{
+		Slack: config.Slack{
+			Channels: config.IdentifiableMap[config.ChannelBindingsByName]{
+				"default": {Name: "bar", Bindings: config.BotBindings{Sources: []string{"k8s-events"}}},
+			},
+		},
+	}
+
+	sources := &fakeSourcesReloader{}
+	logger, _ := logtest.NewNullLogger()
+	reloader := NewConfigReloader(logger, fixReloaderConfig([]string{"k8s-events"}), func() (*config.Config, error) {
+		return newCfg, nil
+	}, sources, &fakeExecutorConfigSetter{}, nil)
+
+	// when
+	_, err := reloader.Reload()
+
+	// then
+	assert.ErrorIs(t, err, ErrRestartRequired)
+	assert.Nil(t, sources.cfg)
+}
+
+func fixReloaderConfig(boundSources []string) *config.Config {
+	return &config.Config{
+		Settings: config.Settings{ClusterName: "foo"},
+		Communications: map[string]config.Communications{
+			"default-group": {
+				Slack: config.Slack{
+					Channels: config.IdentifiableMap[config.ChannelBindingsByName]{
+						"default": {Name: "foo", Bindings: config.BotBindings{Sources: boundSources}},
+					},
+				},
+			},
+		},
+	}
+}
+
+type fakeSourcesReloader struct {
+	cfg     *config.Config
+	summary controller.ReloadSummary
+}
+
+func (f *fakeSourcesReloader) Reload(cfg *config.Config) (controller.ReloadSummary, error) {
+	f.cfg = cfg
+	return f.summary, nil
+}
+
+type fakeExecutorConfigSetter struct {
+	cfg config.Config
+}
+
+func (f *fakeExecutorConfigSetter) SetConfig(cfg config.Config) {
+	f.cfg = cfg
+}
+
+type fakeBindingsUpdater struct {
+	commGroup config.Communications
+}
+
+func (f *fakeBindingsUpdater) SetBindings(commGroup config.Communications) {
+	f.commGroup = commGroup
+}
@@ -1,6 +1,7 @@
 package lifecycle
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -23,17 +24,45 @@ const (
 // SendMessageFn defines a function which sends a given message.
 type SendMessageFn func(msg string) error
 
+// Reloader applies the configuration without restart.
+type Reloader interface {
+	Reload() (string, error)
+}
+
 // NewServer creates a new httpsrv.Server that exposes lifecycle methods as HTTP endpoints.
-func NewServer(log logrus.FieldLogger, k8sCli kubernetes.Interface, cfg config.LifecycleServer, clusterName string, sendMsgFn SendMessageFn) *httpsrv.Server {
+// If the reloader is set, the configuration is applied without restart whenever possible.
+func NewServer(log logrus.FieldLogger, k8sCli kubernetes.Interface, cfg config.LifecycleServer, clusterName string, sendMsgFn SendMessageFn, reloader Reloader) *httpsrv.Server {
 	addr := fmt.Sprintf(":%d", cfg.Port)
 	router := mux.NewRouter()
-	reloadHandler := newReloadHandler(log, k8sCli, cfg.Deployment, clusterName, sendMsgFn)
+	reloadHandler := newReloadHandler(log, k8sCli, cfg.Deployment, clusterName, sendMsgFn, reloader)
 	router.HandleFunc("/reload", reloadHandler)
 	return httpsrv.New(log, addr, router)
 }
 
-func newReloadHandler(log logrus.FieldLogger, k8sCli kubernetes.Interface, deploy config.K8sResourceRef, clusterName string, sendMsgFn SendMessageFn) http.HandlerFunc {
+func newReloadHandler(log logrus.FieldLogger, k8sCli kubernetes.Interface, deploy config.K8sResourceRef, clusterName string, sendMsgFn SendMessageFn, reloader Reloader) http.HandlerFunc {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		if reloader != nil {
+			log.Info("Reload requested. Applying configuration without restart...")
+			msg, err := reloader.Reload()
+			if err == nil {
+				if err := sendMsgFn(msg); err != nil {
+					log.Errorf("while sending reload summary: %s", err.Error())
+				}
+
+				writer.WriteHeader(http.StatusOK)
+				if _, err := writer.Write([]byte(msg)); err != nil {
+					log.Errorf("while writing success response: %s", err.Error())
+				}
+				return
+			}
+
+			if errors.Is(err, ErrRestartRequired) {
+				log.Info("Configuration cannot be applied without restart.")
+			} else {
+				log.Errorf("while applying configuration without restart: %s. Falling back to restart...", err.Error())
+			}
+		}
+
 		log.Info("Reload requested. Sending last message before exit...")
 		err := sendMsgFn(fmt.Sprintf(reloadMsgFmt, clusterName))
 		if err != nil {
@@ -45,7 +45,7 @@ func TestNewReloadHandler_HappyPath(t *testing.T) {
 
 	req := httptest.NewRequest(http.MethodPost, "/reload", nil)
 	writer := httptest.NewRecorder()
-	handler := newReloadHandler(logger, k8sCli, deployCfg, clusterName, sendMsgFn)
+	handler := newReloadHandler(logger, k8sCli, deployCfg, clusterName, sendMsgFn, nil)
 
 	// when
 	handler(writer, req)
@@ -65,3 +65,49 @@ func TestNewReloadHandler_HappyPath(t *testing.T) {
 	_, exists := actualDeploy.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"]
 	assert.True(t, exists)
 }
+
+func TestNewReloadHandler_WithoutRestart(t *testing.T) {
+	// given
+	expectedMsg := ":arrows_counterclockwise: Configuration reloaded for cluster 'foo' without restart.\nUpdated channel bindings: default-group/slack/default"
+	deployCfg := config.K8sResourceRef{
+		Name:      "name",
+		Namespace: "namespace",
+	}
+	inputDeploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deployCfg.Name,
+			Namespace: deployCfg.Namespace,
+		},
+	}
+	sendMsgFn := SendMessageFn(func(msg string) error {
+		assert.Equal(t, expectedMsg, msg)
+		return nil
+	})
+	logger, _ := logtest.NewNullLogger()
+	k8sCli := fake.NewSimpleClientset(inputDeploy)
+	reloader := NewConfigReloader(logger, fixReloaderConfig(nil), func() (*config.Config, error) {
+		return fixReloaderConfig([]string{"k8s-events"}), nil
+	}, &fakeSourcesReloader{}, &fakeExecutorConfigSetter{}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/reload", nil)
+	writer := httptest.NewRecorder()
+	handler := newReloadHandler(logger, k8sCli, deployCfg, "foo", sendMsgFn, reloader)
+
+	// when
+	handler(writer, req)
+
+	res := writer.Result()
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, expectedMsg, string(data))
+
+	actualDeploy, err := k8sCli.AppsV1().Deployments(deployCfg.Namespace).Get(context.Background(), deployCfg.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	_, exists := actualDeploy.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"]
+	assert.False(t, exists)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/notifier"
+	"github.com/kubeshop/botkube/pkg/schedule"
 )
 
 // Bot connects to communication channels and reads/sends messages. It is a two-way integration.
@@ -13,6 +14,7 @@ type Bot interface {
 	Start(ctx context.Context) error
 	BotName() string
 	notifier.Notifier
+	schedule.Bot
 }
 
 // ExecutorFactory facilitates creation of execute.Executor instances.
@@ -50,3 +52,51 @@ type channelConfigByName struct {
 	alias  string
 	notify bool
 }
+
+// BindingsUpdater updates channel bindings of a running bot.
+type BindingsUpdater interface {
+	// SetBindings sets bindings for already configured channels. The channels are identified by their aliases.
+	SetBindings(commGroup config.Communications)
+}
+
+// bindingsByAliasFromNames returns bindings of channels configured by name, indexed by the channel alias.
+func bindingsByAliasFromNames(channels config.IdentifiableMap[config.ChannelBindingsByName]) map[string]config.BotBindings {
+	out := make(map[string]config.BotBindings)
+	for alias, channel := range channels {
+		out[alias] = channel.Bindings
+	}
+	return out
+}
+
+// bindingsByAliasFromIDs returns bindings of channels configured by ID, indexed by the channel alias.
+func bindingsByAliasFromIDs(channels config.IdentifiableMap[config.ChannelBindingsByID]) map[string]config.BotBindings {
+	out := make(map[string]config.BotBindings)
+	for alias, channel := range channels {
+		out[alias] = channel.Bindings
+	}
+	return out
+}
+
+// channelsByNameWithBindings returns a copy of given channels with updated bindings.
+func channelsByNameWithBindings(channels map[string]channelConfigByName, bindings map[string]config.BotBindings) map[string]channelConfigByName {
+	out := make(map[string]channelConfigByName, len(channels))
+	for key, channel := range channels {
+		if channelBindings, exists := bindings[channel.alias]; exists {
+			channel.Bindings = channelBindings
+		}
+		out[key] = channel
+	}
+	return out
+}
+
+// channelsByIDWithBindings returns a copy of given channels with updated bindings.
+func channelsByIDWithBindings(channels map[string]channelConfigByID, bindings map[string]config.BotBindings) map[string]channelConfigByID {
+	out := make(map[string]channelConfigByID, len(channels))
+	for key, channel := range channels {
+		if channelBindings, exists := bindings[channel.alias]; exists {
+			channel.Bindings = channelBindings
+		}
+		out[key] = channel
+	}
+	return out
+}
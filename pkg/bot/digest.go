@@ -0,0 +1,224 @@
+package bot
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/digest"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+const (
+	digestTopNoisyObjects = 5
+
+	notificationDigestHeader = "Notification digest"
+	quietHoursSummaryHeader  = "Quiet hours summary"
+
+	// quietHoursCheckInterval defines how often channels are checked whether their quiet hours are over.
+	quietHoursCheckInterval = time.Minute
+)
+
+// digestSendFn sends a digest message to a given channel.
+type digestSendFn func(ctx context.Context, channelID string, msg interactive.Message) error
+
+// eventDigests aggregates events for channels and sends them grouped in a single message.
+// It is used both for the digest notification mode and for events held back during quiet hours.
+type eventDigests struct {
+	log    logrus.FieldLogger
+	now    func() time.Time
+	header string
+
+	mu         sync.Mutex
+	aggregates map[string]*digest.Aggregate
+}
+
+func newEventDigests(log logrus.FieldLogger, header string) *eventDigests {
+	return &eventDigests{
+		log:        log,
+		now:        time.Now,
+		header:     header,
+		aggregates: map[string]*digest.Aggregate{},
+	}
+}
+
+// Add records an event for a given channel.
+func (d *eventDigests) Add(channelID string, event events.Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	aggregate, found := d.aggregates[channelID]
+	if !found {
+		aggregate = digest.New(d.now())
+		d.aggregates[channelID] = aggregate
+	}
+	aggregate.Add(event)
+}
+
+// deferEvent records a given event in a notification digest or in a quiet hours summary of a given channel,
+// if the channel shouldn't receive the event right away. It returns true if the event was deferred.
+func deferEvent(log logrus.FieldLogger, digests, quietHoursSummaries *eventDigests, channelID string, notification config.ChannelNotification, event events.Event) bool {
+	switch {
+	case notification.IsDigest():
+		digests.Add(channelID, event)
+	case holdBackDuringQuietHours(log, notification.QuietHours, event, time.Now()):
+		quietHoursSummaries.Add(channelID, event)
+	default:
+		return false
+	}
+	return true
+}
+
+// Run sends digests for given channels according to their cron expressions. It blocks until the context is cancelled.
+// Channels with invalid cron expressions are skipped, so they don't prevent sending digests to other channels.
+func (d *eventDigests) Run(ctx context.Context, channels map[string]config.ChannelDigest, send digestSendFn) {
+	if len(channels) == 0 {
+		return
+	}
+
+	c := cron.New()
+	for channelID, channelDigest := range channels {
+		channelID := channelID
+		_, err := c.AddFunc(channelDigest.CronOrDefault(), func() {
+			if err := d.send(ctx, channelID, send); err != nil {
+				d.log.Errorf("while sending digest to channel %q: %s", channelID, err.Error())
+			}
+		})
+		if err != nil {
+			d.log.Errorf("while adding digest for channel %q with cron %q: %s. Skipping...", channelID, channelDigest.CronOrDefault(), err.Error())
+		}
+	}
+
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+}
+
+// RunWhenReady periodically sends buffered events to channels which are ready to receive them,
+// for example once their quiet hours are over. It blocks until the context is cancelled.
+func (d *eventDigests) RunWhenReady(ctx context.Context, interval time.Duration, isReady func(channelID string) bool, send digestSendFn) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.sendReady(ctx, isReady, send)
+		}
+	}
+}
+
+func (d *eventDigests) sendReady(ctx context.Context, isReady func(channelID string) bool, send digestSendFn) {
+	for _, channelID := range d.bufferedChannels() {
+		if !isReady(channelID) {
+			continue
+		}
+
+		if err := d.send(ctx, channelID, send); err != nil {
+			d.log.Errorf("while sending %s to channel %q: %s", strings.ToLower(d.header), channelID, err.Error())
+		}
+	}
+}
+
+func (d *eventDigests) bufferedChannels() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var out []string
+	for channelID := range d.aggregates {
+		out = append(out, channelID)
+	}
+	return out
+}
+
+func (d *eventDigests) send(ctx context.Context, channelID string, send digestSendFn) error {
+	aggregate := d.flush(channelID)
+	if aggregate == nil || aggregate.Total == 0 {
+		d.log.Debugf("No events buffered for channel %q. Skipping %s...", channelID, strings.ToLower(d.header))
+		return nil
+	}
+
+	return send(ctx, channelID, renderEventDigest(d.header, aggregate))
+}
+
+func (d *eventDigests) flush(channelID string) *digest.Aggregate {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	aggregate := d.aggregates[channelID]
+	delete(d.aggregates, channelID)
+	return aggregate
+}
+
+// renderEventDigest renders aggregated events grouped by kind and reason, with the noisiest objects and raised recommendations.
+func renderEventDigest(header string, aggregate *digest.Aggregate) interactive.Message {
+	kindReasonTable := tabular(func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "KIND\tREASON\tCOUNT")
+		for _, item := range aggregate.KindReasons() {
+			fmt.Fprintf(w, "%s\t%s\t%d\n", item.Key.Kind, item.Key.Reason, item.Count)
+		}
+	})
+	objectsTable := tabular(func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "KIND\tOBJECT\tCOUNT")
+		for _, item := range aggregate.TopObjects(digestTopNoisyObjects) {
+			fmt.Fprintf(w, "%s\t%s\t%d\n", item.Key.Kind, item.Key.Ref(), item.Count)
+		}
+	})
+
+	msg := interactive.Message{
+		Base: interactive.Base{
+			Header:      header,
+			Description: fmt.Sprintf("%d events on the `%s` cluster since %s", aggregate.Total, aggregate.Cluster, aggregate.Since.UTC().Format(time.RFC1123)),
+		},
+		Sections: []interactive.Section{
+			{
+				Base: interactive.Base{
+					Header: "Events by kind and reason",
+					Body: interactive.Body{
+						CodeBlock: kindReasonTable,
+					},
+				},
+			},
+			{
+				Base: interactive.Base{
+					Header: "Top noisy objects",
+					Body: interactive.Body{
+						CodeBlock: objectsTable,
+					},
+				},
+			},
+		},
+	}
+
+	if recommendations := aggregate.Recommendations(); len(recommendations) > 0 {
+		msg.Sections = append(msg.Sections, interactive.Section{
+			Base: interactive.Base{
+				Header: "Recommendations",
+				Body: interactive.Body{
+					Plaintext: "- " + strings.Join(recommendations, "\n- "),
+				},
+			},
+		})
+	}
+
+	return msg
+}
+
+func tabular(fn func(w *tabwriter.Writer)) string {
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fn(w)
+	w.Flush()
+	return buf.String()
+}
@@ -0,0 +1,181 @@
+package bot
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/digest"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestRenderEventDigest(t *testing.T) {
+	// given
+	since := time.Date(2022, 9, 1, 9, 0, 0, 0, time.UTC)
+	podEvent := func(name, reason string, recommendations ...string) events.Event {
+		return events.Event{
+			TypeMeta:        metaV1.TypeMeta{Kind: "Pod"},
+			Name:            name,
+			Namespace:       "default",
+			Reason:          reason,
+			Type:            config.ErrorEvent,
+			Cluster:         "prod",
+			Recommendations: recommendations,
+		}
+	}
+	in := []events.Event{
+		podEvent("api", "BackOff"),
+		podEvent("api", "BackOff", "Pod 'default/api' created without labels."),
+		podEvent("api", "BackOff", "Pod 'default/api' created without labels."),
+		podEvent("worker", "FailedMount"),
+		{
+			TypeMeta: metaV1.TypeMeta{Kind: "Node"},
+			Name:     "node-1",
+			Type:     config.UpdateEvent,
+			Cluster:  "prod",
+		},
+	}
+
+	aggregate := digest.New(since)
+	for _, event := range in {
+		aggregate.Add(event)
+	}
+
+	// when
+	msg := renderEventDigest(notificationDigestHeader, aggregate)
+
+	// then
+	assert.Equal(t, "Notification digest", msg.Header)
+	assert.Equal(t, "5 events on the `prod` cluster since Thu, 01 Sep 2022 09:00:00 UTC", msg.Description)
+	require.Len(t, msg.Sections, 3)
+	assert.Equal(t, heredoc.Doc(`
+		KIND REASON      COUNT
+		Pod  BackOff     3
+		Node update      1
+		Pod  FailedMount 1
+	`), msg.Sections[0].Body.CodeBlock)
+	assert.Equal(t, heredoc.Doc(`
+		KIND OBJECT         COUNT
+		Pod  default/api    3
+		Node node-1         1
+		Pod  default/worker 1
+	`), msg.Sections[1].Body.CodeBlock)
+	assert.Equal(t, "- Pod default/api: Pod 'default/api' created without labels.", msg.Sections[2].Body.Plaintext)
+}
+
+func TestEventDigests_SendFlushesBuffer(t *testing.T) {
+	// given
+	digests := newEventDigests(logrus.New(), notificationDigestHeader)
+	digests.Add("alerts", events.Event{Name: "api", Cluster: "prod"})
+
+	var sent []interactive.Message
+	send := func(_ context.Context, channelID string, msg interactive.Message) error {
+		assert.Equal(t, "alerts", channelID)
+		sent = append(sent, msg)
+		return nil
+	}
+
+	// when
+	require.NoError(t, digests.send(context.Background(), "alerts", send))
+	require.NoError(t, digests.send(context.Background(), "alerts", send))
+
+	// then
+	assert.Len(t, sent, 1)
+}
+
+func TestEventDigests_RunSkipsInvalidCron(t *testing.T) {
+	// given
+	digests := newEventDigests(logrus.New(), notificationDigestHeader)
+	digests.Add("invalid", events.Event{Name: "api", Cluster: "prod"})
+	digests.Add("alerts", events.Event{Name: "api", Cluster: "prod"})
+
+	channels := map[string]config.ChannelDigest{
+		"invalid": {Cron: "every morning"},
+		"alerts":  {Cron: "@every 1s"},
+	}
+
+	sent := make(chan string, 2)
+	send := func(_ context.Context, channelID string, _ interactive.Message) error {
+		sent <- channelID
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// when
+	go digests.Run(ctx, channels, send)
+
+	// then
+	select {
+	case channelID := <-sent:
+		assert.Equal(t, "alerts", channelID)
+	case <-ctx.Done():
+		t.Fatal("digest was not sent")
+	}
+}
+
+func TestEventDigests_SendReadyOnlyAfterQuietHours(t *testing.T) {
+	// given
+	log := logrus.New()
+	quietHours := config.QuietHours{Start: "22:00", End: "07:00"}
+	night := time.Date(2022, 9, 1, 23, 0, 0, 0, time.UTC)
+	morning := time.Date(2022, 9, 2, 7, 0, 0, 0, time.UTC)
+
+	digests := newEventDigests(log, quietHoursSummaryHeader)
+	for _, event := range []events.Event{
+		{Name: "api", Level: config.Info, Cluster: "prod"},
+		{Name: "db", Level: config.Error, Cluster: "prod"},
+	} {
+		if holdBackDuringQuietHours(log, quietHours, event, night) {
+			digests.Add("alerts", event)
+		}
+	}
+
+	var sent []interactive.Message
+	send := func(_ context.Context, _ string, msg interactive.Message) error {
+		sent = append(sent, msg)
+		return nil
+	}
+
+	// when
+	digests.sendReady(context.Background(), func(string) bool { return !quietHoursActive(log, quietHours, night) }, send)
+
+	// then
+	assert.Empty(t, sent)
+
+	// when
+	digests.sendReady(context.Background(), func(string) bool { return !quietHoursActive(log, quietHours, morning) }, send)
+
+	// then
+	require.Len(t, sent, 1)
+	assert.Equal(t, quietHoursSummaryHeader, sent[0].Header)
+	assert.Contains(t, sent[0].Sections[1].Body.CodeBlock, "api")
+	assert.NotContains(t, sent[0].Sections[1].Body.CodeBlock, "db")
+}
+
+func TestDeferEvent(t *testing.T) {
+	// given
+	log := logrus.New()
+	digests := newEventDigests(log, notificationDigestHeader)
+	quietHoursSummaries := newEventDigests(log, quietHoursSummaryHeader)
+	event := events.Event{Name: "api", Level: config.Error, Cluster: "prod"}
+
+	// when
+	deferredDigest := deferEvent(log, digests, quietHoursSummaries, "digest", config.ChannelNotification{Mode: config.DigestNotificationMode}, event)
+	deferredRealtime := deferEvent(log, digests, quietHoursSummaries, "realtime", config.ChannelNotification{Mode: config.RealtimeNotificationMode}, event)
+
+	// then
+	assert.True(t, deferredDigest)
+	assert.False(t, deferredRealtime)
+	assert.ElementsMatch(t, []string{"digest"}, digests.bufferedChannels())
+	assert.Empty(t, quietHoursSummaries.bufferedChannels())
+}
@@ -6,15 +6,19 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/execute"
+	"github.com/kubeshop/botkube/pkg/execute/kubectl"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/schedule"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -34,6 +38,13 @@ const (
 
 	// discordMaxMessageSize max size before a message should be uploaded as a file.
 	discordMaxMessageSize = 2000
+
+	// discordMaxEmbedFieldSize is the max number of characters in the embed field value.
+	discordMaxEmbedFieldSize = 1024
+	// discordMaxEmbedDescriptionSize is the max number of characters in the embed description.
+	discordMaxEmbedDescriptionSize = 4096
+	// discordMaxEmbedSize is the max number of characters in all embed titles, descriptions, field names and values.
+	discordMaxEmbedSize = 6000
 )
 
 var embedColor = map[config.Level]int{
@@ -46,18 +57,22 @@ var embedColor = map[config.Level]int{
 
 // Discord listens for user's message, execute commands and sends back the response.
 type Discord struct {
-	log             logrus.FieldLogger
-	executorFactory ExecutorFactory
-	reporter        AnalyticsReporter
-	api             *discordgo.Session
-	notification    config.Notification
-	botID           string
-	channelsMutex   sync.RWMutex
-	channels        map[string]channelConfigByID
-	notifyMutex     sync.Mutex
-	botMentionRegex *regexp.Regexp
-	commGroupName   string
-	mdFormatter     interactive.MDFormatter
+	log                 logrus.FieldLogger
+	executorFactory     ExecutorFactory
+	kcMerger            *kubectl.Merger
+	reporter            FatalErrorAnalyticsReporter
+	api                 *discordgo.Session
+	notification        config.Notification
+	botID               string
+	channelsMutex       sync.RWMutex
+	channels            map[string]channelConfigByID
+	notifyMutex         sync.Mutex
+	botMentionRegex     *regexp.Regexp
+	commGroupName       string
+	mdFormatter         interactive.MDFormatter
+	digests             *eventDigests
+	quietHoursSummaries *eventDigests
+	eventActions        *EventActions
 }
 
 // discordMessage contains message details to execute command and send back the result.
@@ -66,7 +81,7 @@ type discordMessage struct {
 }
 
 // NewDiscord creates a new Discord instance.
-func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord, executorFactory ExecutorFactory, reporter AnalyticsReporter) (*Discord, error) {
+func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord, executorFactory ExecutorFactory, kcMerger *kubectl.Merger, eventActions *EventActions, reporter FatalErrorAnalyticsReporter) (*Discord, error) {
 	botMentionRegex, err := discordBotMentionRegex(cfg.BotID)
 	if err != nil {
 		return nil, err
@@ -80,16 +95,20 @@ func NewDiscord(log logrus.FieldLogger, commGroupName string, cfg config.Discord
 	channelsCfg := discordChannelsConfigFrom(cfg.Channels)
 
 	return &Discord{
-		log:             log,
-		reporter:        reporter,
-		executorFactory: executorFactory,
-		api:             api,
-		botID:           cfg.BotID,
-		notification:    cfg.Notification,
-		commGroupName:   commGroupName,
-		channels:        channelsCfg,
-		botMentionRegex: botMentionRegex,
-		mdFormatter:     interactive.DefaultMDFormatter(),
+		log:                 log,
+		reporter:            reporter,
+		executorFactory:     executorFactory,
+		kcMerger:            kcMerger,
+		eventActions:        eventActions,
+		api:                 api,
+		botID:               cfg.BotID,
+		notification:        cfg.Notification,
+		commGroupName:       commGroupName,
+		channels:            channelsCfg,
+		botMentionRegex:     botMentionRegex,
+		mdFormatter:         interactive.DefaultMDFormatter(),
+		digests:             newEventDigests(log, notificationDigestHeader),
+		quietHoursSummaries: newEventDigests(log, quietHoursSummaryHeader),
 	}, nil
 }
 
@@ -107,6 +126,20 @@ func (b *Discord) Start(ctx context.Context) error {
 		}
 	})
 
+	// Register slash commands for each guild the bot is member of, also for the ones joined later.
+	b.api.AddHandler(func(s *discordgo.Session, g *discordgo.GuildCreate) {
+		if err := b.registerSlashCommands(g.ID); err != nil {
+			b.log.Errorf("Slash commands registration error: %s", err.Error())
+		}
+	})
+
+	// Register the interactionCreate func as a callback for slash commands and message components.
+	b.api.AddHandler(func(s *discordgo.Session, i *discordgo.InteractionCreate) {
+		if err := b.handleInteraction(i); err != nil {
+			b.log.Errorf("Interaction handling error: %s", err.Error())
+		}
+	})
+
 	// Open a websocket connection to Discord and begin listening.
 	err := b.api.Open()
 	if err != nil {
@@ -120,6 +153,16 @@ func (b *Discord) Start(ctx context.Context) error {
 
 	b.log.Info("BotKube connected to Discord!")
 
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.digests.Run(ctx, b.getDigestChannels(), b.sendDigest)
+	}()
+
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.quietHoursSummaries.RunWhenReady(ctx, quietHoursCheckInterval, b.quietHoursOver, b.sendDigest)
+	}()
+
 	<-ctx.Done()
 	b.log.Info("Shutdown requested. Finishing...")
 	err = b.api.Close()
@@ -135,11 +178,15 @@ func (b *Discord) Start(ctx context.Context) error {
 func (b *Discord) SendEvent(_ context.Context, event events.Event, eventSources []string) (err error) {
 	b.log.Debugf("Sending to Discord: %+v", event)
 
-	msgToSend := b.formatMessage(event)
-
 	errs := multierror.New()
-	for _, channelID := range b.getChannelsToNotify(eventSources) {
-		msg := msgToSend // copy as the struct is modified when using Discord API client
+	for _, channelID := range b.getChannelsToNotify(event, eventSources) {
+		channel, found := b.getChannels()[channelID]
+		if found && deferEvent(b.log, b.digests, b.quietHoursSummaries, channelID, channel.Notification, event) {
+			continue
+		}
+
+		msg := b.formatMessage(event.ForSources(channel.Bindings.Sources), channel.Notification.Template)
+		msg.Components = b.renderEventActions(event, channel.Bindings.Executors)
 		if _, err := b.api.ChannelMessageSendComplex(channelID, &msg); err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
 			continue
@@ -160,7 +207,11 @@ func (b *Discord) SendMessage(_ context.Context, msg interactive.Message) error
 		plaintext := interactive.RenderMessage(b.mdFormatter, msg)
 		b.log.Debugf("Sending message to channel %q: %s", channelID, plaintext)
 
-		if _, err := b.api.ChannelMessageSend(channelID, plaintext); err != nil {
+		params := &discordgo.MessageSend{
+			Content:    plaintext,
+			Components: b.renderComponents(msg),
+		}
+		if _, err := b.api.ChannelMessageSendComplex(channelID, params); err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err))
 			continue
 		}
@@ -170,6 +221,31 @@ func (b *Discord) SendMessage(_ context.Context, msg interactive.Message) error
 	return errs.ErrorOrNil()
 }
 
+// SendScheduledReport sends a scheduled report to Discord channels bound to a given schedule.
+// Context is not supported by client: See https://github.com/bwmarrin/discordgo/issues/752.
+func (b *Discord) SendScheduledReport(_ context.Context, scheduleName string, render schedule.ReportRenderer) error {
+	errs := multierror.New()
+	for _, channel := range b.getChannels() {
+		if !sliceutil.Intersect([]string{scheduleName}, channel.Bindings.Schedules) {
+			continue
+		}
+
+		msg := render(schedule.Channel{Alias: channel.alias, ID: channel.ID, Bindings: channel.Bindings})
+		params, err := b.renderMessage(scheduleName, msg)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while rendering scheduled report for channel %q: %w", channel.ID, err))
+			continue
+		}
+		if _, err := b.api.ChannelMessageSendComplex(channel.ID, params); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending scheduled report to channel %q: %w", channel.ID, err))
+			continue
+		}
+		b.log.Debugf("Scheduled report %q successfully sent to channel %q", scheduleName, channel.ID)
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // IntegrationName describes the integration name.
 func (b *Discord) IntegrationName() config.CommPlatformIntegration {
 	return config.DiscordCommPlatformIntegration
@@ -181,16 +257,17 @@ func (b *Discord) Type() config.IntegrationType {
 }
 
 // TODO: Support custom routing via annotations for Discord as well
-func (b *Discord) getChannelsToNotify(eventSources []string) []string {
+func (b *Discord) getChannelsToNotify(event events.Event, eventSources []string) []string {
 	var out []string
 	for _, cfg := range b.getChannels() {
 		switch {
 		case !cfg.notify:
 			b.log.Infof("Skipping notification for channel %q as notifications are disabled.", cfg.Identifier())
+		case !sliceutil.Intersect(eventSources, cfg.Bindings.Sources):
+		case !event.Level.IsAtLeast(cfg.Bindings.MinLevel):
+			b.log.Debugf("Skipping notification for channel %q as the event level %q is lower than %q.", cfg.Identifier(), event.Level, cfg.Bindings.MinLevel)
 		default:
-			if sliceutil.Intersect(eventSources, cfg.Bindings.Sources) {
-				out = append(out, cfg.Identifier())
-			}
+			out = append(out, cfg.Identifier())
 		}
 	}
 	return out
@@ -225,6 +302,40 @@ func (b *Discord) SetNotificationsEnabled(channelID string, enabled bool) error
 	return nil
 }
 
+// QuietHours returns quiet hours configured for a given channel ID.
+func (b *Discord) QuietHours(channelID string) config.QuietHours {
+	channel, exists := b.getChannels()[channelID]
+	if !exists {
+		return config.QuietHours{}
+	}
+
+	return channel.Notification.QuietHours
+}
+
+// SetQuietHours sets quiet hours for a given channel ID. Empty quiet hours disable them.
+func (b *Discord) SetQuietHours(channelID string, quietHours config.QuietHours) error {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	channels := b.getChannels()
+	channel, exists := channels[channelID]
+	if !exists {
+		return execute.ErrNotificationsNotConfigured
+	}
+
+	channel.Notification.QuietHours = quietHours
+	channels[channelID] = channel
+	b.setChannels(channels)
+
+	return nil
+}
+
+// quietHoursOver returns true if quiet hours are not active for a given channel ID.
+func (b *Discord) quietHoursOver(channelID string) bool {
+	return !quietHoursActive(b.log, b.QuietHours(channelID), time.Now())
+}
+
 // HandleMessage handles the incoming messages.
 func (b *Discord) handleMessage(dm discordMessage) error {
 	// Handle message only if starts with mention
@@ -234,44 +345,124 @@ func (b *Discord) handleMessage(dm discordMessage) error {
 		return nil
 	}
 
-	channel, isAuthChannel := b.getChannels()[dm.Event.ChannelID]
+	response := b.execute(dm.Event.ChannelID, dm.Event.Author.ID, req, false)
+
+	params, err := b.renderMessage(req, response)
+	if err != nil {
+		return err
+	}
+
+	if _, err := b.api.ChannelMessageSendComplex(dm.Event.ChannelID, params); err != nil {
+		return fmt.Errorf("while sending message: %w", err)
+	}
+
+	return nil
+}
+
+// handleInteraction handles the incoming slash commands, autocomplete requests and message components interactions.
+func (b *Discord) handleInteraction(i *discordgo.InteractionCreate) error {
+	var (
+		req                 string
+		isButtonClickOrigin bool
+	)
+
+	switch i.Type {
+	case discordgo.InteractionApplicationCommandAutocomplete:
+		input := slashCommandOption(i.ApplicationCommandData())
+		err := b.api.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+			Data: &discordgo.InteractionResponseData{
+				Choices: b.autocompleteChoices(i.ChannelID, input),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("while responding with autocomplete choices: %w", err)
+		}
+		return nil
+	case discordgo.InteractionApplicationCommand:
+		req = slashCommandOption(i.ApplicationCommandData())
+	case discordgo.InteractionMessageComponent:
+		req = b.resolveComponentCommand(i.MessageComponentData())
+		isButtonClickOrigin = true
+	default:
+		b.log.Debugf("Ignoring unsupported interaction type %q", i.Type.String())
+		return nil
+	}
+
+	// Discord requires the interaction response within 3 seconds, so it's acknowledged before the command is executed.
+	err := b.api.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		return fmt.Errorf("while acknowledging interaction: %w", err)
+	}
+
+	response := b.execute(i.ChannelID, interactionUserID(i.Interaction), req, isButtonClickOrigin)
+
+	params, err := b.renderMessage(req, response)
+	if err != nil {
+		return err
+	}
+
+	followup := &discordgo.WebhookParams{
+		Content:    params.Content,
+		Components: params.Components,
+		Files:      params.Files,
+	}
+	if response.OnlyVisibleForYou {
+		// The deferred response is visible for everyone, so it's replaced with an ephemeral follow-up message.
+		if err := b.api.InteractionResponseDelete(i.Interaction); err != nil {
+			return fmt.Errorf("while deleting deferred interaction response: %w", err)
+		}
+		followup.Flags = uint64(discordgo.MessageFlagsEphemeral)
+	}
+
+	if _, err := b.api.FollowupMessageCreate(i.Interaction, true, followup); err != nil {
+		return fmt.Errorf("while sending interaction follow-up message: %w", err)
+	}
+
+	return nil
+}
+
+func (b *Discord) execute(channelID, userID, req string, isButtonClickOrigin bool) interactive.Message {
+	channel, isAuthChannel := b.getChannels()[channelID]
+	conversationID := channel.Identifier()
+	if !isAuthChannel {
+		// keep the conversations which are not configured apart
+		conversationID = channelID
+	}
 
 	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: b,
 		Conversation: execute.Conversation{
-			Alias:            channel.alias,
-			ID:               channel.Identifier(),
-			ExecutorBindings: channel.Bindings.Executors,
-			IsAuthenticated:  isAuthChannel,
+			Alias:               channel.alias,
+			ID:                  conversationID,
+			ExecutorBindings:    channel.Bindings.Executors,
+			IsAuthenticated:     isAuthChannel,
+			IsButtonClickOrigin: isButtonClickOrigin,
 		},
 		Message: req,
-		User:    fmt.Sprintf("<@%s>", dm.Event.Author.ID),
+		User:    fmt.Sprintf("<@%s>", userID),
 	})
 
-	response := e.Execute()
-	err := b.send(dm.Event, req, response)
-	if err != nil {
-		return fmt.Errorf("while sending message: %w", err)
-	}
-
-	return nil
+	return e.Execute()
 }
 
-func (b *Discord) send(event *discordgo.MessageCreate, req string, resp interactive.Message) error {
+func (b *Discord) renderMessage(req string, resp interactive.Message) (*discordgo.MessageSend, error) {
 	b.log.Debugf("Discord incoming Request: %s", req)
 	b.log.Debugf("Discord Response: %s", resp)
 
 	markdown := interactive.RenderMessage(b.mdFormatter, resp)
 
 	if len(markdown) == 0 {
-		return fmt.Errorf("while reading Slack response: empty response for request %q", req)
+		return nil, fmt.Errorf("while reading Discord response: empty response for request %q", req)
 	}
 
 	// Upload message as a file if too long
 	if len(markdown) >= discordMaxMessageSize {
-		params := &discordgo.MessageSend{
+		return &discordgo.MessageSend{
 			Content: resp.Description,
 			Files: []*discordgo.File{
 				{
@@ -279,15 +470,57 @@ func (b *Discord) send(event *discordgo.MessageCreate, req string, resp interact
 					Reader: strings.NewReader(interactive.MessageToPlaintext(resp, interactive.NewlineFormatter)),
 				},
 			},
+		}, nil
+	}
+
+	return &discordgo.MessageSend{
+		Content:    markdown,
+		Components: b.renderComponents(resp),
+	}, nil
+}
+
+// resolveComponentCommand returns the command stored in the component custom ID.
+// For select menus, the selected values are appended as a comma separated list.
+func (b *Discord) resolveComponentCommand(data discordgo.MessageComponentInteractionData) string {
+	cmd := data.CustomID
+	if data.ComponentType == discordgo.SelectMenuComponent {
+		cmd = fmt.Sprintf("%s %s", cmd, strings.Join(data.Values, ","))
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(cmd, b.BotName()))
+}
+
+// interactionUserID returns ID of the user who triggered a given interaction.
+func interactionUserID(i *discordgo.Interaction) string {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User.ID
+	}
+	if i.User != nil {
+		return i.User.ID
+	}
+	return ""
+}
+
+// getDigestChannels returns digest configuration for channels with the digest notification mode.
+func (b *Discord) getDigestChannels() map[string]config.ChannelDigest {
+	out := map[string]config.ChannelDigest{}
+	for _, cfg := range b.getChannels() {
+		if cfg.Notification.IsDigest() {
+			out[cfg.Identifier()] = cfg.Notification.Digest
 		}
-		if _, err := b.api.ChannelMessageSendComplex(event.ChannelID, params); err != nil {
-			return fmt.Errorf("while uploading file: %w", err)
-		}
-		return nil
 	}
+	return out
+}
 
-	if _, err := b.api.ChannelMessageSend(event.ChannelID, markdown); err != nil {
-		return fmt.Errorf("while sending message: %w", err)
+// sendDigest sends the notification digest to a given Discord channel.
+// Context is not supported by client: See https://github.com/bwmarrin/discordgo/issues/752.
+func (b *Discord) sendDigest(_ context.Context, channelID string, msg interactive.Message) error {
+	params, err := b.renderMessage("digest", msg)
+	if err != nil {
+		return err
+	}
+	if _, err := b.api.ChannelMessageSendComplex(channelID, params); err != nil {
+		return fmt.Errorf("while sending Discord message to channel %q: %w", channelID, err)
 	}
 	return nil
 }
@@ -301,6 +534,15 @@ func (b *Discord) BotName() string {
 	return "@BotKube"
 }
 
+// SetBindings sets bindings for already configured channels without restart.
+func (b *Discord) SetBindings(commGroup config.Communications) {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	b.setChannels(channelsByIDWithBindings(b.getChannels(), bindingsByAliasFromIDs(commGroup.Discord.Channels)))
+}
+
 func (b *Discord) getChannels() map[string]channelConfigByID {
 	b.channelsMutex.RLock()
 	defer b.channelsMutex.RUnlock()
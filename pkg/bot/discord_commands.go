@@ -0,0 +1,109 @@
+package bot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// discordSlashCommandName is the name of the Discord application command used to run BotKube commands.
+	discordSlashCommandName = "botkube"
+	// discordSlashCommandOptionName is the name of the option holding the actual BotKube command.
+	discordSlashCommandOptionName = "command"
+	// discordMaxAutocompleteChoices is the maximum number of choices returned for autocomplete interaction.
+	discordMaxAutocompleteChoices = 25
+)
+
+// slashCommands returns application commands registered for each guild the bot is member of.
+func (b *Discord) slashCommands() []*discordgo.ApplicationCommand {
+	return []*discordgo.ApplicationCommand{
+		{
+			Name:        discordSlashCommandName,
+			Description: "Run a BotKube command",
+			Options: []*discordgo.ApplicationCommandOption{
+				{
+					Type:         discordgo.ApplicationCommandOptionString,
+					Name:         discordSlashCommandOptionName,
+					Description:  "Command to execute, e.g. get pods",
+					Required:     true,
+					Autocomplete: true,
+				},
+			},
+		},
+	}
+}
+
+// registerSlashCommands registers application commands for a given guild.
+func (b *Discord) registerSlashCommands(guildID string) error {
+	_, err := b.api.ApplicationCommandBulkOverwrite(b.botID, guildID, b.slashCommands())
+	if err != nil {
+		return fmt.Errorf("while registering application commands for guild %q: %w", guildID, err)
+	}
+
+	return nil
+}
+
+// slashCommandOption returns the command option value from the application command interaction.
+func slashCommandOption(data discordgo.ApplicationCommandInteractionData) string {
+	for _, opt := range data.Options {
+		if opt.Name == discordSlashCommandOptionName {
+			return opt.StringValue()
+		}
+	}
+	return ""
+}
+
+// autocompleteChoices suggests kubectl verbs and resources enabled for a given channel.
+// The first word is completed with verbs, the second one with resources allowed for a given verb.
+func (b *Discord) autocompleteChoices(channelID, input string) []*discordgo.ApplicationCommandOptionChoice {
+	channel, found := b.getChannels()[channelID]
+	if !found || b.kcMerger == nil {
+		return nil
+	}
+
+	enabled := b.kcMerger.MergeAllEnabled(channel.Bindings.Executors)
+
+	args := strings.Fields(input)
+	startsNewWord := input == "" || strings.HasSuffix(input, " ")
+
+	var prefix, current string
+	var candidates map[string]struct{}
+	switch {
+	case len(args) == 0, len(args) == 1 && !startsNewWord:
+		candidates = enabled.AllowedKubectlVerb
+		current = strings.Join(args, "")
+	case len(args) == 1 && startsNewWord, len(args) == 2 && !startsNewWord:
+		if _, found := enabled.AllowedKubectlVerb[args[0]]; !found {
+			return nil
+		}
+		candidates = enabled.AllowedKubectlResource
+		prefix = args[0] + " "
+		current = strings.Join(args[1:], "")
+	default:
+		return nil
+	}
+
+	var values []string
+	for candidate := range candidates {
+		if strings.HasPrefix(candidate, current) {
+			values = append(values, prefix+candidate)
+		}
+	}
+	sort.Strings(values)
+
+	if len(values) > discordMaxAutocompleteChoices {
+		values = values[:discordMaxAutocompleteChoices]
+	}
+
+	var out []*discordgo.ApplicationCommandOptionChoice
+	for _, value := range values {
+		out = append(out, &discordgo.ApplicationCommandOptionChoice{
+			Name:  value,
+			Value: value,
+		})
+	}
+	return out
+}
@@ -2,6 +2,8 @@ package bot
 
 import (
 	"fmt"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/bwmarrin/discordgo"
 
@@ -10,18 +12,24 @@ import (
 	formatx "github.com/kubeshop/botkube/pkg/format"
 )
 
-func (b *Discord) formatMessage(event events.Event) discordgo.MessageSend {
+// truncatedSuffix is appended to the embed texts which were shortened to fit the Discord limits.
+const truncatedSuffix = "…"
+
+func (b *Discord) formatMessage(event events.Event, channelTemplate config.EventTemplate) discordgo.MessageSend {
 	var messageEmbed discordgo.MessageEmbed
 
-	switch b.notification.Type {
-	case config.LongNotification:
+	event, files := splitDiscordEventFiles(event)
+
+	custom, isCustom := renderCustomEventMessage(b.log, b.IntegrationName(), channelTemplate, event)
+	switch {
+	case isCustom:
+		// generate notification message from a custom template
+		messageEmbed = b.customNotification(custom)
+
+	case b.notification.Type == config.LongNotification:
 		// generate Long notification message
 		messageEmbed = b.longNotification(event)
 
-	case config.ShortNotification:
-		// generate Short notification message
-		fallthrough
-
 	default:
 		// generate Short notification message
 		messageEmbed = b.shortNotification(event)
@@ -29,9 +37,11 @@ func (b *Discord) formatMessage(event events.Event) discordgo.MessageSend {
 
 	messageEmbed.Timestamp = event.TimeStamp.UTC().Format(customTimeFormat)
 	messageEmbed.Color = embedColor[event.Level]
+	limitDiscordEmbed(&messageEmbed)
 
 	return discordgo.MessageSend{
 		Embed: &messageEmbed,
+		Files: files,
 	}
 }
 
@@ -57,11 +67,15 @@ func (b *Discord) longNotification(event events.Event) discordgo.MessageEmbed {
 	}
 
 	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, event.Namespace, "Namespace", true)
+	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, event.Workload(), "Workload", true)
+	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, event.Related(), "Related", true)
 	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, event.Reason, "Reason", true)
 	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, formatx.JoinMessages(event.Messages), "Message", false)
 	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, event.Action, "Action", true)
 	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, formatx.JoinMessages(event.Recommendations), "Recommendations", false)
 	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, formatx.JoinMessages(event.Warnings), "Warnings", false)
+	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, formatx.OptionalCodeBlock(event.Diff), "Diff", false)
+	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, formatx.OptionalCodeBlock(event.Logs), "Logs", false)
 	messageEmbed.Fields = b.appendIfNotEmpty(messageEmbed.Fields, event.Cluster, "Cluster", false)
 
 	return messageEmbed
@@ -78,6 +92,16 @@ func (b *Discord) appendIfNotEmpty(fields []*discordgo.MessageEmbedField, in str
 	})
 }
 
+func (b *Discord) customNotification(msg customEventMessage) discordgo.MessageEmbed {
+	return discordgo.MessageEmbed{
+		Title:       msg.Title,
+		Description: msg.Body,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: "BotKube",
+		},
+	}
+}
+
 func (b *Discord) shortNotification(event events.Event) discordgo.MessageEmbed {
 	return discordgo.MessageEmbed{
 		Title:       event.Title,
@@ -87,3 +111,87 @@ func (b *Discord) shortNotification(event events.Event) discordgo.MessageEmbed {
 		},
 	}
 }
+
+// splitDiscordEventFiles removes the diff and logs from a given event if they don't fit into a single embed field.
+// The removed texts are returned as files, so they can be attached to the message.
+func splitDiscordEventFiles(event events.Event) (events.Event, []*discordgo.File) {
+	var files []*discordgo.File
+	if utf8.RuneCountInString(formatx.OptionalCodeBlock(event.Diff)) > discordMaxEmbedFieldSize {
+		files = append(files, &discordgo.File{
+			Name:        fmt.Sprintf("%s.diff", event.Name),
+			ContentType: "text/plain",
+			Reader:      strings.NewReader(event.Diff),
+		})
+		event.Diff = ""
+	}
+	if utf8.RuneCountInString(formatx.OptionalCodeBlock(event.Logs)) > discordMaxEmbedFieldSize {
+		files = append(files, &discordgo.File{
+			Name:        fmt.Sprintf("%s.log", event.Name),
+			ContentType: "text/plain",
+			Reader:      strings.NewReader(event.Logs),
+		})
+		event.Logs = ""
+	}
+
+	return event, files
+}
+
+// limitDiscordEmbed truncates the embed description and field values, so the embed is not rejected by Discord.
+// If the whole embed is still too long, the description and then the field values, starting from the last one, are shortened.
+func limitDiscordEmbed(embed *discordgo.MessageEmbed) {
+	embed.Description = truncateRunes(embed.Description, discordMaxEmbedDescriptionSize)
+	for _, field := range embed.Fields {
+		field.Value = truncateRunes(field.Value, discordMaxEmbedFieldSize)
+	}
+
+	excess := discordEmbedSize(embed) - discordMaxEmbedSize
+	if excess <= 0 {
+		return
+	}
+
+	texts := []*string{&embed.Description}
+	for i := len(embed.Fields) - 1; i >= 0; i-- {
+		texts = append(texts, &embed.Fields[i].Value)
+	}
+	for _, text := range texts {
+		if excess <= 0 {
+			return
+		}
+		size := utf8.RuneCountInString(*text)
+		if size == 0 {
+			continue
+		}
+
+		target := size - excess
+		if target < 0 {
+			target = 0
+		}
+		*text = truncateRunes(*text, target)
+		excess -= size - utf8.RuneCountInString(*text)
+	}
+}
+
+// discordEmbedSize returns the number of characters counted by Discord against the embed size limit.
+func discordEmbedSize(embed *discordgo.MessageEmbed) int {
+	size := utf8.RuneCountInString(embed.Title) + utf8.RuneCountInString(embed.Description)
+	if embed.Footer != nil {
+		size += utf8.RuneCountInString(embed.Footer.Text)
+	}
+	for _, field := range embed.Fields {
+		size += utf8.RuneCountInString(field.Name) + utf8.RuneCountInString(field.Value)
+	}
+	return size
+}
+
+// truncateRunes shortens a given text to a given number of characters, including the truncatedSuffix.
+func truncateRunes(in string, max int) string {
+	if utf8.RuneCountInString(in) <= max {
+		return in
+	}
+
+	suffix := []rune(truncatedSuffix)
+	if max <= len(suffix) {
+		return string(suffix[:max])
+	}
+	return string([]rune(in)[:max-len(suffix)]) + truncatedSuffix
+}
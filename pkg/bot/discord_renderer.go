@@ -0,0 +1,137 @@
+package bot
+
+import (
+	"github.com/bwmarrin/discordgo"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+const (
+	// discordMaxActionRows is the maximum number of action rows per message.
+	discordMaxActionRows = 5
+	// discordMaxButtonsInRow is the maximum number of buttons in a single action row.
+	discordMaxButtonsInRow = 5
+	// discordMaxSelectOptions is the maximum number of options in a single select menu.
+	discordMaxSelectOptions = 25
+	// discordMaxCustomIDLength is the maximum length of the component custom ID.
+	discordMaxCustomIDLength = 100
+)
+
+var discordButtonStyle = map[interactive.ButtonStyle]discordgo.ButtonStyle{
+	interactive.ButtonStyleDefault: discordgo.SecondaryButton,
+	interactive.ButtonStylePrimary: discordgo.PrimaryButton,
+	interactive.ButtonStyleDanger:  discordgo.DangerButton,
+}
+
+// renderComponents converts interactive message sections into Discord message components.
+// Command buttons and multi selects store the command in the custom ID, so it can be executed once the interaction is received.
+func (b *Discord) renderComponents(msg interactive.Message) []discordgo.MessageComponent {
+	var rows []discordgo.MessageComponent
+	addRow := func(components []discordgo.MessageComponent) {
+		if len(components) == 0 {
+			return
+		}
+		if len(rows) >= discordMaxActionRows {
+			b.log.Debugf("Skipping components as the Discord limit of %d action rows was reached", discordMaxActionRows)
+			return
+		}
+		rows = append(rows, discordgo.ActionsRow{Components: components})
+	}
+
+	for _, section := range msg.Sections {
+		if section.MultiSelect.AreOptionsDefined() {
+			addRow(b.renderMultiSelect(section.MultiSelect))
+		}
+
+		var buttons []discordgo.MessageComponent
+		for _, btn := range section.Buttons {
+			button, ok := b.renderButton(btn)
+			if !ok {
+				continue
+			}
+
+			buttons = append(buttons, button)
+			if len(buttons) == discordMaxButtonsInRow {
+				addRow(buttons)
+				buttons = nil
+			}
+		}
+		addRow(buttons)
+	}
+
+	return rows
+}
+
+// renderEventActions returns Discord message components with action buttons allowed for a given event.
+func (b *Discord) renderEventActions(event events.Event, executorBindings []string) []discordgo.MessageComponent {
+	buttons := b.eventActions.ForEvent(event, executorBindings, b.BotName())
+	if len(buttons) == 0 {
+		return nil
+	}
+
+	return b.renderComponents(interactive.Message{
+		Sections: []interactive.Section{
+			{Buttons: buttons},
+		},
+	})
+}
+
+func (b *Discord) renderButton(btn interactive.Button) (discordgo.MessageComponent, bool) {
+	if btn.URL != "" {
+		return discordgo.Button{
+			Label: btn.Name,
+			Style: discordgo.LinkButton,
+			URL:   btn.URL,
+		}, true
+	}
+
+	if btn.Command == "" || len(btn.Command) > discordMaxCustomIDLength {
+		b.log.Debugf("Skipping button %q as its command is empty or exceeds %d characters", btn.Name, discordMaxCustomIDLength)
+		return nil, false
+	}
+
+	return discordgo.Button{
+		Label:    btn.Name,
+		Style:    discordButtonStyle[btn.Style],
+		CustomID: btn.Command,
+	}, true
+}
+
+func (b *Discord) renderMultiSelect(in interactive.MultiSelect) []discordgo.MessageComponent {
+	if len(in.Command) > discordMaxCustomIDLength {
+		b.log.Debugf("Skipping multi select %q as its command exceeds %d characters", in.Name, discordMaxCustomIDLength)
+		return nil
+	}
+
+	selected := map[string]struct{}{}
+	for _, opt := range in.InitialOptions {
+		selected[opt.Value] = struct{}{}
+	}
+
+	var options []discordgo.SelectMenuOption
+	for _, opt := range in.Options {
+		if len(options) == discordMaxSelectOptions {
+			b.log.Debugf("Skipping remaining options of multi select %q as the Discord limit of %d options was reached", in.Name, discordMaxSelectOptions)
+			break
+		}
+
+		_, isSelected := selected[opt.Value]
+		options = append(options, discordgo.SelectMenuOption{
+			Label:   opt.Name,
+			Value:   opt.Value,
+			Default: isSelected,
+		})
+	}
+
+	minValues := 0
+	return []discordgo.MessageComponent{
+		discordgo.SelectMenu{
+			CustomID:    in.Command,
+			Placeholder: in.Name,
+			MinValues:   &minValues,
+			MaxValues:   len(options),
+			Options:     options,
+		},
+	}
+}
@@ -1,10 +1,20 @@
 package bot
 
 import (
+	"io"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
+	"github.com/bwmarrin/discordgo"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/execute/kubectl"
 )
 
 func TestDiscord_FindAndTrimBotMention(t *testing.T) {
@@ -55,3 +65,228 @@ func TestDiscord_FindAndTrimBotMention(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscord_AutocompleteChoices(t *testing.T) {
+	// given
+	b := &Discord{
+		kcMerger: kubectl.NewMerger(map[string]config.Executors{
+			"kubectl-read-only": {
+				Kubectl: config.Kubectl{
+					Enabled: true,
+					Commands: config.Commands{
+						Verbs:     []string{"get", "describe", "logs"},
+						Resources: []string{"pods", "deployments", "services"},
+					},
+				},
+			},
+		}),
+		channels: map[string]channelConfigByID{
+			"123": {
+				ChannelBindingsByID: config.ChannelBindingsByID{
+					ID: "123",
+					Bindings: config.BotBindings{
+						Executors: []string{"kubectl-read-only"},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name      string
+		ChannelID string
+		Input     string
+		Expected  []string
+	}{
+		{
+			Name:      "All verbs",
+			ChannelID: "123",
+			Input:     "",
+			Expected:  []string{"describe", "get", "logs"},
+		},
+		{
+			Name:      "Verbs with prefix",
+			ChannelID: "123",
+			Input:     "de",
+			Expected:  []string{"describe"},
+		},
+		{
+			Name:      "All resources",
+			ChannelID: "123",
+			Input:     "get ",
+			Expected:  []string{"get deployments", "get pods", "get services"},
+		},
+		{
+			Name:      "Resources with prefix",
+			ChannelID: "123",
+			Input:     "get p",
+			Expected:  []string{"get pods"},
+		},
+		{
+			Name:      "Unknown verb",
+			ChannelID: "123",
+			Input:     "delete p",
+			Expected:  nil,
+		},
+		{
+			Name:      "Not configured channel",
+			ChannelID: "456",
+			Input:     "",
+			Expected:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// when
+			choices := b.autocompleteChoices(tc.ChannelID, tc.Input)
+
+			// then
+			var actual []string
+			for _, choice := range choices {
+				actual = append(actual, choice.Name)
+				assert.Equal(t, choice.Name, choice.Value)
+			}
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestDiscord_RenderComponents(t *testing.T) {
+	// given
+	b := &Discord{log: logrus.New()}
+	msg := interactive.Message{
+		Sections: []interactive.Section{
+			{
+				MultiSelect: interactive.MultiSelect{
+					Name:    "Adjust notifications",
+					Command: "@BotKube edit SourceBindings",
+					Options: []interactive.OptionItem{
+						{Name: "K8s errors", Value: "k8s-err-events"},
+						{Name: "K8s recommendations", Value: "k8s-recommendation-events"},
+					},
+					InitialOptions: []interactive.OptionItem{
+						{Name: "K8s errors", Value: "k8s-err-events"},
+					},
+				},
+			},
+			{
+				Buttons: interactive.Buttons{
+					{Name: "Start notifications", Command: "@BotKube notifier start", Style: interactive.ButtonStylePrimary},
+					{Name: "Docs", URL: "https://botkube.io/docs"},
+				},
+			},
+		},
+	}
+
+	// when
+	components := b.renderComponents(msg)
+
+	// then
+	require.Len(t, components, 2)
+
+	selectRow, ok := components[0].(discordgo.ActionsRow)
+	require.True(t, ok)
+	require.Len(t, selectRow.Components, 1)
+	selectMenu, ok := selectRow.Components[0].(discordgo.SelectMenu)
+	require.True(t, ok)
+	assert.Equal(t, "@BotKube edit SourceBindings", selectMenu.CustomID)
+	assert.Equal(t, 2, selectMenu.MaxValues)
+	assert.True(t, selectMenu.Options[0].Default)
+	assert.False(t, selectMenu.Options[1].Default)
+
+	buttonsRow, ok := components[1].(discordgo.ActionsRow)
+	require.True(t, ok)
+	assert.Equal(t, []discordgo.MessageComponent{
+		discordgo.Button{Label: "Start notifications", Style: discordgo.PrimaryButton, CustomID: "@BotKube notifier start"},
+		discordgo.Button{Label: "Docs", Style: discordgo.LinkButton, URL: "https://botkube.io/docs"},
+	}, buttonsRow.Components)
+}
+
+func TestDiscord_ResolveComponentCommand(t *testing.T) {
+	// given
+	b := &Discord{}
+
+	// when
+	btnCmd := b.resolveComponentCommand(discordgo.MessageComponentInteractionData{
+		CustomID:      "@BotKube notifier start",
+		ComponentType: discordgo.ButtonComponent,
+	})
+	selectCmd := b.resolveComponentCommand(discordgo.MessageComponentInteractionData{
+		CustomID:      "@BotKube edit SourceBindings",
+		ComponentType: discordgo.SelectMenuComponent,
+		Values:        []string{"k8s-err-events", "k8s-recommendation-events"},
+	})
+
+	// then
+	assert.Equal(t, "notifier start", btnCmd)
+	assert.Equal(t, "edit SourceBindings k8s-err-events,k8s-recommendation-events", selectCmd)
+}
+
+func TestDiscord_FormatMessageFitsEmbedLimits(t *testing.T) {
+	// given
+	b := &Discord{
+		log:          logrus.New(),
+		notification: config.Notification{Type: config.LongNotification},
+	}
+	logs := strings.Repeat("panic: missing config\n", 100)
+	event := events.Event{
+		Name:            "api",
+		Namespace:       "team-a",
+		Type:            config.ErrorEvent,
+		Level:           config.Error,
+		Logs:            logs,
+		Diff:            "spec.replicas:\n\t-: 1\n\t+: 2",
+		Messages:        []string{strings.Repeat("Back-off restarting failed container. ", 100)},
+		Recommendations: []string{strings.Repeat("Check the container command. ", 100)},
+		Warnings:        []string{strings.Repeat("Container uses the latest image tag. ", 100)},
+	}
+	event.Kind = "Pod"
+
+	// when
+	msg := b.formatMessage(event, config.EventTemplate{})
+
+	// then
+	require.Len(t, msg.Files, 1)
+	assert.Equal(t, "api.log", msg.Files[0].Name)
+	gotLogs, err := io.ReadAll(msg.Files[0].Reader)
+	require.NoError(t, err)
+	assert.Equal(t, logs, string(gotLogs))
+
+	fieldNames := map[string]struct{}{}
+	for _, field := range msg.Embed.Fields {
+		fieldNames[field.Name] = struct{}{}
+		assert.LessOrEqual(t, utf8.RuneCountInString(field.Value), discordMaxEmbedFieldSize)
+	}
+	assert.Contains(t, fieldNames, "Diff")
+	assert.NotContains(t, fieldNames, "Logs")
+	assert.LessOrEqual(t, discordEmbedSize(msg.Embed), discordMaxEmbedSize)
+}
+
+func TestLimitDiscordEmbed(t *testing.T) {
+	// given
+	embed := &discordgo.MessageEmbed{
+		Title:       "Pod error",
+		Description: strings.Repeat("d", 4000),
+		Fields: []*discordgo.MessageEmbedField{
+			{Name: "Message", Value: strings.Repeat("m", 1000)},
+			{Name: "Recommendations", Value: strings.Repeat("r", 1000)},
+			{Name: "Warnings", Value: strings.Repeat("w", 2000)},
+		},
+	}
+
+	// when
+	limitDiscordEmbed(embed)
+
+	// then
+	assert.Equal(t, discordMaxEmbedSize, discordEmbedSize(embed))
+	assert.Equal(t, strings.Repeat("w", discordMaxEmbedFieldSize-1)+truncatedSuffix, embed.Fields[2].Value)
+	assert.True(t, strings.HasSuffix(embed.Description, truncatedSuffix))
+	assert.Equal(t, strings.Repeat("m", 1000), embed.Fields[0].Value)
+}
+
+func TestTruncateRunes(t *testing.T) {
+	assert.Equal(t, "zażółć", truncateRunes("zażółć", 6))
+	assert.Equal(t, "zaż…", truncateRunes("zażółć", 4))
+	assert.Equal(t, "", truncateRunes("zażółć", 0))
+}
@@ -0,0 +1,138 @@
+package bot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/execute"
+	"github.com/kubeshop/botkube/pkg/execute/kubectl"
+)
+
+const (
+	podsResource        = "pods"
+	deploymentsResource = "deployments"
+	eventsResource      = "events"
+)
+
+// eventAction defines a kubectl command that can be run against the object of a given event.
+type eventAction struct {
+	name  string
+	verb  string
+	style interactive.ButtonStyle
+	// resource is the resource checked against the kubectl executor configuration.
+	// If empty, only the verb is checked, the same as kubectl executor does for commands such as `logs`.
+	resource string
+	command  string
+	// confirm defines whether the command is run only after an additional confirmation.
+	confirm bool
+}
+
+// EventActions builds the action buttons attached to event notifications.
+// Only commands allowed by the kubectl executors bound to a given channel are returned.
+type EventActions struct {
+	merger    *kubectl.Merger
+	kcChecker *kubectl.Checker
+}
+
+// NewEventActions returns a new EventActions instance.
+func NewEventActions(merger *kubectl.Merger, kcChecker *kubectl.Checker) *EventActions {
+	return &EventActions{
+		merger:    merger,
+		kcChecker: kcChecker,
+	}
+}
+
+// ForEvent returns the action buttons for a given event.
+func (a *EventActions) ForEvent(event events.Event, executorBindings []string, botName string) interactive.Buttons {
+	if a == nil || a.merger == nil || event.Name == "" || event.Type == config.DeleteEvent {
+		return nil
+	}
+
+	kcConfig := a.merger.MergeForNamespace(executorBindings, event.Namespace)
+	btnBuilder := interactive.NewButtonBuilder(botName)
+
+	var out interactive.Buttons
+	for _, action := range eventActionsFor(event) {
+		if !a.kcChecker.IsVerbAllowedInNs(kcConfig, action.verb) {
+			continue
+		}
+		if action.resource != "" && !a.kcChecker.IsResourceAllowedInNs(kcConfig, action.resource) {
+			continue
+		}
+
+		cmd := withEventScope(action.command, event)
+		if action.confirm {
+			cmd = fmt.Sprintf("%s %s", execute.ConfirmCommand, cmd)
+		}
+
+		btn := btnBuilder.ForCommand(action.name, cmd)
+		btn.Style = action.style
+		out = append(out, btn)
+	}
+
+	return out
+}
+
+func eventActionsFor(event events.Event) []eventAction {
+	resource := resourceFromEvent(event)
+
+	actions := []eventAction{
+		{
+			name:     "Describe",
+			verb:     "describe",
+			resource: resource,
+			command:  fmt.Sprintf("describe %s %s", resource, event.Name),
+		},
+	}
+
+	if resource == podsResource {
+		actions = append(actions, eventAction{
+			name:    "Logs",
+			verb:    "logs",
+			command: fmt.Sprintf("logs %s", event.Name),
+		})
+	}
+
+	actions = append(actions, eventAction{
+		name:     "Get events",
+		verb:     "get",
+		resource: eventsResource,
+		command:  fmt.Sprintf("get events --field-selector involvedObject.name=%s", event.Name),
+	})
+
+	if resource == deploymentsResource {
+		actions = append(actions, eventAction{
+			name:     "Rollout restart",
+			verb:     "rollout",
+			resource: deploymentsResource,
+			style:    interactive.ButtonStyleDanger,
+			command:  fmt.Sprintf("rollout restart deployments/%s", event.Name),
+			confirm:  true,
+		})
+	}
+
+	return actions
+}
+
+// resourceFromEvent returns the resource name from the event, e.g. `pods` for `v1/pods`.
+func resourceFromEvent(event events.Event) string {
+	if event.Resource != "" {
+		parts := strings.Split(event.Resource, "/")
+		return parts[len(parts)-1]
+	}
+	return strings.ToLower(event.Kind)
+}
+
+// withEventScope adds the Namespace and cluster name flags, so the command targets the event object.
+func withEventScope(cmd string, event events.Event) string {
+	if event.Namespace != "" {
+		cmd = fmt.Sprintf("%s -n %s", cmd, event.Namespace)
+	}
+	if event.Cluster != "" {
+		cmd = fmt.Sprintf("%s %s %s", cmd, execute.ClusterFlag.String(), event.Cluster)
+	}
+	return cmd
+}
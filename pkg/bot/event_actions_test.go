@@ -0,0 +1,165 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/execute/kubectl"
+)
+
+func TestEventActions_ForEvent(t *testing.T) {
+	// given
+	actions := NewEventActions(kubectl.NewMerger(map[string]config.Executors{
+		"kubectl-read-only": {
+			Kubectl: config.Kubectl{
+				Enabled: true,
+				Namespaces: config.Namespaces{
+					Include: []string{"team-a", "team-b"},
+				},
+				Commands: config.Commands{
+					Verbs:     []string{"get", "describe", "logs"},
+					Resources: []string{"pods", "deployments", "events"},
+				},
+			},
+		},
+		"kubectl-rollout": {
+			Kubectl: config.Kubectl{
+				Enabled: true,
+				Namespaces: config.Namespaces{
+					Include: []string{"team-a"},
+				},
+				Commands: config.Commands{
+					Verbs:     []string{"rollout"},
+					Resources: []string{"deployments"},
+				},
+			},
+		},
+	}), kubectl.NewChecker(nil))
+
+	bindings := []string{"kubectl-read-only", "kubectl-rollout"}
+	btnBuilder := interactive.NewButtonBuilder("@BotKube")
+	fixRolloutBtn := func(cmd string) interactive.Button {
+		btn := btnBuilder.ForCommand("Rollout restart", "confirm "+cmd)
+		btn.Style = interactive.ButtonStyleDanger
+		return btn
+	}
+
+	tests := []struct {
+		name       string
+		event      events.Event
+		bindings   []string
+		expButtons interactive.Buttons
+	}{
+		{
+			name: "Pod",
+			event: events.Event{
+				Type:      config.ErrorEvent,
+				Resource:  "v1/pods",
+				Name:      "api",
+				Namespace: "team-a",
+				Cluster:   "dev",
+			},
+			bindings: bindings,
+			expButtons: interactive.Buttons{
+				btnBuilder.ForCommand("Describe", "describe pods api -n team-a --cluster-name dev"),
+				btnBuilder.ForCommand("Logs", "logs api -n team-a --cluster-name dev"),
+				btnBuilder.ForCommand("Get events", "get events --field-selector involvedObject.name=api -n team-a --cluster-name dev"),
+			},
+		},
+		{
+			name: "Deployment",
+			event: events.Event{
+				Type:      config.UpdateEvent,
+				Resource:  "apps/v1/deployments",
+				Name:      "api",
+				Namespace: "team-a",
+			},
+			bindings: bindings,
+			expButtons: interactive.Buttons{
+				btnBuilder.ForCommand("Describe", "describe deployments api -n team-a"),
+				btnBuilder.ForCommand("Get events", "get events --field-selector involvedObject.name=api -n team-a"),
+				fixRolloutBtn("rollout restart deployments/api -n team-a"),
+			},
+		},
+		{
+			name: "Deployment in Namespace without rollout",
+			event: events.Event{
+				Type:      config.UpdateEvent,
+				Resource:  "apps/v1/deployments",
+				Name:      "api",
+				Namespace: "team-b",
+			},
+			bindings: bindings,
+			expButtons: interactive.Buttons{
+				btnBuilder.ForCommand("Describe", "describe deployments api -n team-b"),
+				btnBuilder.ForCommand("Get events", "get events --field-selector involvedObject.name=api -n team-b"),
+			},
+		},
+		{
+			name: "Resource not allowed",
+			event: events.Event{
+				Type:      config.CreateEvent,
+				Resource:  "v1/services",
+				Name:      "api",
+				Namespace: "team-a",
+			},
+			bindings: bindings,
+			expButtons: interactive.Buttons{
+				btnBuilder.ForCommand("Get events", "get events --field-selector involvedObject.name=api -n team-a"),
+			},
+		},
+		{
+			name: "Namespace not allowed",
+			event: events.Event{
+				Type:      config.ErrorEvent,
+				Resource:  "v1/pods",
+				Name:      "api",
+				Namespace: "kube-system",
+			},
+			bindings: bindings,
+		},
+		{
+			name: "No executor bindings",
+			event: events.Event{
+				Type:      config.ErrorEvent,
+				Resource:  "v1/pods",
+				Name:      "api",
+				Namespace: "team-a",
+			},
+		},
+		{
+			name: "Deleted object",
+			event: events.Event{
+				Type:      config.DeleteEvent,
+				Resource:  "v1/pods",
+				Name:      "api",
+				Namespace: "team-a",
+			},
+			bindings: bindings,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			buttons := actions.ForEvent(tc.event, tc.bindings, "@BotKube")
+
+			// then
+			assert.Equal(t, tc.expButtons, buttons)
+		})
+	}
+}
+
+func TestEventActions_ForEventNotConfigured(t *testing.T) {
+	// given
+	var actions *EventActions
+
+	// when
+	buttons := actions.ForEvent(events.Event{Type: config.ErrorEvent, Resource: "v1/pods", Name: "api"}, []string{"kubectl-read-only"}, "@BotKube")
+
+	// then
+	assert.Empty(t, buttons)
+}
@@ -0,0 +1,36 @@
+package bot
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	formatx "github.com/kubeshop/botkube/pkg/format"
+)
+
+// customEventMessage holds the event title and body rendered from a custom template.
+type customEventMessage struct {
+	Title string
+	Body  string
+}
+
+// renderCustomEventMessage renders a given event with the channel template, or with the event source template if the channel doesn't define one.
+// Title and body overrides for a given platform take precedence over the common ones. It returns false if there is no template or it cannot be rendered. In such case, the default message format should be used.
+func renderCustomEventMessage(log logrus.FieldLogger, platform config.CommPlatformIntegration, channelTemplate config.EventTemplate, event events.Event) (customEventMessage, bool) {
+	tpl := channelTemplate
+	if tpl.IsEmpty() {
+		tpl = event.Template
+	}
+	tpl = tpl.ForPlatform(platform)
+	if tpl.IsEmpty() {
+		return customEventMessage{}, false
+	}
+
+	title, body, err := formatx.RenderEventTemplate(tpl, event)
+	if err != nil {
+		log.Errorf("while rendering custom event template: %s. Using the default format...", err.Error())
+		return customEventMessage{}, false
+	}
+
+	return customEventMessage{Title: title, Body: body}, true
+}
@@ -0,0 +1,126 @@
+package bot
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestRenderCustomEventMessage(t *testing.T) {
+	// given
+	event := events.Event{
+		Name:     "api",
+		Title:    "default title",
+		Template: config.EventTemplate{Title: "source: {{ .Name }}", Body: "source body"},
+	}
+
+	testCases := []struct {
+		Name            string
+		Platform        config.CommPlatformIntegration
+		ChannelTemplate config.EventTemplate
+		Event           events.Event
+		Expected        customEventMessage
+		ExpectedCustom  bool
+	}{
+		{
+			Name:           "Source template",
+			Event:          event,
+			Expected:       customEventMessage{Title: "source: api", Body: "source body"},
+			ExpectedCustom: true,
+		},
+		{
+			Name:            "Channel template overrides source template",
+			ChannelTemplate: config.EventTemplate{Title: "channel: {{ .Name }}", Body: "channel body"},
+			Event:           event,
+			Expected:        customEventMessage{Title: "channel: api", Body: "channel body"},
+			ExpectedCustom:  true,
+		},
+		{
+			Name:     "Platform override takes precedence",
+			Platform: config.DiscordCommPlatformIntegration,
+			ChannelTemplate: config.EventTemplate{
+				Title: "channel: {{ .Name }}",
+				Body:  "channel body",
+				Platforms: map[config.CommPlatformIntegration]config.PlatformEventTemplate{
+					config.DiscordCommPlatformIntegration: {Body: "discord body"},
+					config.SlackCommPlatformIntegration:   {Body: "slack body"},
+				},
+			},
+			Event:          event,
+			Expected:       customEventMessage{Title: "channel: api", Body: "discord body"},
+			ExpectedCustom: true,
+		},
+		{
+			Name:           "No template",
+			Event:          events.Event{Name: "api"},
+			ExpectedCustom: false,
+		},
+		{
+			Name:            "Invalid template falls back to default format",
+			ChannelTemplate: config.EventTemplate{Body: "{{ .Unknown }}"},
+			Event:           event,
+			ExpectedCustom:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// when
+			actual, isCustom := renderCustomEventMessage(logrus.New(), tc.Platform, tc.ChannelTemplate, tc.Event)
+
+			// then
+			assert.Equal(t, tc.ExpectedCustom, isCustom)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestRenderCustomEventMessageForChannelSources(t *testing.T) {
+	// given
+	event := events.Event{
+		Name: "api",
+		SourceTemplates: map[string]config.EventTemplate{
+			"k8s-templated": {Title: "templated: {{ .Name }}", Body: "templated body"},
+		},
+	}
+
+	testCases := []struct {
+		Name           string
+		ChannelSources []string
+		Expected       customEventMessage
+		ExpectedCustom bool
+	}{
+		{
+			Name:           "Channel bound to source with template",
+			ChannelSources: []string{"k8s-templated"},
+			Expected:       customEventMessage{Title: "templated: api", Body: "templated body"},
+			ExpectedCustom: true,
+		},
+		{
+			Name:           "Channel bound to both sources",
+			ChannelSources: []string{"k8s-default", "k8s-templated"},
+			Expected:       customEventMessage{Title: "templated: api", Body: "templated body"},
+			ExpectedCustom: true,
+		},
+		{
+			Name:           "Channel bound only to source without template",
+			ChannelSources: []string{"k8s-default"},
+			ExpectedCustom: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// when
+			actual, isCustom := renderCustomEventMessage(logrus.New(), config.SlackCommPlatformIntegration, config.EventTemplate{}, event.ForSources(tc.ChannelSources))
+
+			// then
+			assert.Equal(t, tc.ExpectedCustom, isCustom)
+			assert.Equal(t, tc.Expected, actual)
+		})
+	}
+}
@@ -2,7 +2,7 @@ package interactive
 
 // Feedback generates Message structure.
 func Feedback() Message {
-	btnBuilder := buttonBuilder{}
+	btnBuilder := NewButtonBuilder("")
 	return Message{
 		Sections: []Section{
 			{
@@ -11,7 +11,7 @@ import (
 
 // Help represent a help message with interactive sections.
 func Help(platform config.CommPlatformIntegration, clusterName, botName string) Message {
-	btnBuilder := buttonBuilder{botName: botName}
+	btnBuilder := NewButtonBuilder(botName)
 	return Message{
 		Base: Base{
 			Description: fmt.Sprintf("BotKube is now active for %q cluster :rocket:", clusterName),
@@ -20,17 +20,33 @@ func Help(platform config.CommPlatformIntegration, clusterName, botName string)
 			{
 				Base: Base{
 					Header:      "Using multiple instances",
-					Description: fmt.Sprintf("If you are running multiple BotKube instances in the same channel to interact with %s, make sure to specify the cluster name when typing commands.", clusterName),
+					Description: fmt.Sprintf("If you are running multiple BotKube instances in the same channel to interact with %s, select the cluster for your commands or specify the cluster name when typing them.", clusterName),
 					Body: Body{
-						CodeBlock: fmt.Sprintf("--cluster-name=%q\n", clusterName),
+						CodeBlock: fmt.Sprintf("%s use cluster %s\n%s clusters list\n--cluster-name=%q\n", botName, clusterName, botName, clusterName),
 					},
 				},
+				MultiSelect: MultiSelect{
+					Name: "Select cluster",
+					Description: Body{
+						Plaintext: "Commands without the cluster name will be executed only on the selected clusters.",
+					},
+					Command: fmt.Sprintf("%s use cluster", botName),
+					Options: []OptionItem{
+						{
+							Name:  clusterName,
+							Value: clusterName,
+						},
+					},
+				},
+				Buttons: []Button{
+					btnBuilder.ForCommandWithDescCmd("Use all clusters", "use cluster --all"),
+				},
 			},
 			{
 				Base: Base{
 					Header: "Manage incoming notifications",
 					Body: Body{
-						CodeBlock: fmt.Sprintf("%s notifier [start|stop|status]\n", botName),
+						CodeBlock: fmt.Sprintf("%s notifier [start|stop|status]\n%s notifier schedule [HH:MM-HH:MM [timezone] [min-level]|off]\n", botName, botName),
 					},
 				},
 				Buttons: []Button{
@@ -76,6 +92,27 @@ func Help(platform config.CommPlatformIntegration, clusterName, botName string)
 					btnBuilder.ForCommandWithDescCmd("List commands", "commands list"),
 				},
 			},
+			{
+				Base: Base{
+					Header:      "Check recommendations",
+					Description: "Run the enabled recommendations against existing resources.",
+					Body: Body{
+						CodeBlock: fmt.Sprintf("%s check <kind> [name] [-n namespace|-A]\n", botName),
+					},
+				},
+				Buttons: []Button{
+					btnBuilder.ForCommandWithDescCmd("Check pods", "check pods"),
+				},
+			},
+			{
+				Base: Base{
+					Header:      "Cluster status",
+					Description: "Get a health snapshot of nodes, Pods, persistent volume claims, Jobs and recent warning Events.",
+				},
+				Buttons: []Button{
+					btnBuilder.ForCommandWithDescCmd("Show status", "status cluster"),
+				},
+			},
 			{
 				Base: Base{
 					Header: "Filters (advanced)",
@@ -112,13 +112,18 @@ type Button struct {
 	Style       ButtonStyle
 }
 
-// buttonBuilder provides a simplified way to construct a Button model.
-type buttonBuilder struct {
+// ButtonBuilder provides a simplified way to construct a Button model.
+type ButtonBuilder struct {
 	botName string
 }
 
+// NewButtonBuilder returns a new ButtonBuilder instance for a given bot name.
+func NewButtonBuilder(botName string) *ButtonBuilder {
+	return &ButtonBuilder{botName: botName}
+}
+
 // ForCommandWithDescCmd returns button command where description and command are the same.
-func (b *buttonBuilder) ForCommandWithDescCmd(name, cmd string, style ...ButtonStyle) Button {
+func (b *ButtonBuilder) ForCommandWithDescCmd(name, cmd string, style ...ButtonStyle) Button {
 	bt := ButtonStyleDefault
 	if len(style) > 0 {
 		bt = style[0]
@@ -126,7 +131,8 @@ func (b *buttonBuilder) ForCommandWithDescCmd(name, cmd string, style ...ButtonS
 	return b.commandWithDesc(name, cmd, cmd, bt)
 }
 
-func (b *buttonBuilder) DescriptionURL(name, cmd string, url string, style ...ButtonStyle) Button {
+// DescriptionURL returns link button with command description.
+func (b *ButtonBuilder) DescriptionURL(name, cmd string, url string, style ...ButtonStyle) Button {
 	bt := ButtonStyleDefault
 	if len(style) > 0 {
 		bt = style[0]
@@ -141,7 +147,7 @@ func (b *buttonBuilder) DescriptionURL(name, cmd string, url string, style ...Bu
 }
 
 // ForCommand returns button command without description.
-func (b *buttonBuilder) ForCommand(name, cmd string) Button {
+func (b *ButtonBuilder) ForCommand(name, cmd string) Button {
 	cmd = fmt.Sprintf("%s %s", b.botName, cmd)
 	return Button{
 		Name:    name,
@@ -150,7 +156,7 @@ func (b *buttonBuilder) ForCommand(name, cmd string) Button {
 }
 
 // ForURL returns link button.
-func (b *buttonBuilder) ForURL(name, url string, style ...ButtonStyle) Button {
+func (b *ButtonBuilder) ForURL(name, url string, style ...ButtonStyle) Button {
 	bt := ButtonStyleDefault
 	if len(style) > 0 {
 		bt = style[0]
@@ -163,7 +169,7 @@ func (b *buttonBuilder) ForURL(name, url string, style ...ButtonStyle) Button {
 	}
 }
 
-func (b *buttonBuilder) commandWithDesc(name, cmd, desc string, style ButtonStyle) Button {
+func (b *ButtonBuilder) commandWithDesc(name, cmd, desc string, style ButtonStyle) Button {
 	cmd = fmt.Sprintf("%s %s", b.botName, cmd)
 	desc = fmt.Sprintf("%s %s", b.botName, desc)
 	return Button{
@@ -8,15 +8,18 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/mattermost/mattermost-server/v6/model"
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/schedule"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -45,37 +48,39 @@ const (
 
 // Mattermost listens for user's message, execute commands and sends back the response.
 type Mattermost struct {
-	log             logrus.FieldLogger
-	executorFactory ExecutorFactory
-	reporter        AnalyticsReporter
-	notification    config.Notification
-	serverURL       string
-	botName         string
-	teamName        string
-	webSocketURL    string
-	wsClient        *model.WebSocketClient
-	apiClient       *model.Client4
-	channelsMutex   sync.RWMutex
-	commGroupName   string
-	channels        map[string]channelConfigByID
-	notifyMutex     sync.Mutex
-	botMentionRegex *regexp.Regexp
-	mdFormatter     interactive.MDFormatter
+	log                 logrus.FieldLogger
+	executorFactory     ExecutorFactory
+	reporter            FatalErrorAnalyticsReporter
+	notification        config.Notification
+	serverURL           string
+	botName             string
+	teamName            string
+	webSocketURL        string
+	wsClient            *model.WebSocketClient
+	apiClient           *model.Client4
+	channelsMutex       sync.RWMutex
+	commGroupName       string
+	channels            map[string]channelConfigByID
+	notifyMutex         sync.Mutex
+	botMentionRegex     *regexp.Regexp
+	mdFormatter         interactive.MDFormatter
+	interactivity       config.MattermostInteractivity
+	digests             *eventDigests
+	quietHoursSummaries *eventDigests
+	eventActions        *EventActions
 }
 
 // mattermostMessage contains message details to execute command and send back the result
 type mattermostMessage struct {
-	log             logrus.FieldLogger
-	executorFactory ExecutorFactory
+	log logrus.FieldLogger
 
 	Event         *model.WebSocketEvent
-	APIClient     *model.Client4
 	Request       string
 	IsAuthChannel bool
 }
 
 // NewMattermost creates a new Mattermost instance.
-func NewMattermost(log logrus.FieldLogger, commGroupName string, cfg config.Mattermost, executorFactory ExecutorFactory, reporter AnalyticsReporter) (*Mattermost, error) {
+func NewMattermost(log logrus.FieldLogger, commGroupName string, cfg config.Mattermost, executorFactory ExecutorFactory, eventActions *EventActions, reporter FatalErrorAnalyticsReporter) (*Mattermost, error) {
 	botMentionRegex, err := mattermostBotMentionRegex(cfg.BotName)
 	if err != nil {
 		return nil, err
@@ -114,20 +119,35 @@ func NewMattermost(log logrus.FieldLogger, commGroupName string, cfg config.Matt
 		return nil, fmt.Errorf("while producing channels configuration map by ID: %w", err)
 	}
 
+	interactivity := cfg.Interactivity
+	if interactivity.Port == "" {
+		interactivity.Port = mattermostDefaultInteractivityPort
+	}
+	if interactivity.Enabled && interactivity.Secret == "" {
+		interactivity.Secret, err = newMattermostInteractivitySecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &Mattermost{
-		log:             log,
-		executorFactory: executorFactory,
-		reporter:        reporter,
-		notification:    cfg.Notification,
-		serverURL:       cfg.URL,
-		botName:         cfg.BotName,
-		teamName:        cfg.Team,
-		apiClient:       client,
-		webSocketURL:    webSocketURL,
-		commGroupName:   commGroupName,
-		channels:        channelsByIDCfg,
-		botMentionRegex: botMentionRegex,
-		mdFormatter:     interactive.DefaultMDFormatter(),
+		log:                 log,
+		executorFactory:     executorFactory,
+		reporter:            reporter,
+		notification:        cfg.Notification,
+		serverURL:           cfg.URL,
+		botName:             cfg.BotName,
+		teamName:            cfg.Team,
+		apiClient:           client,
+		webSocketURL:        webSocketURL,
+		commGroupName:       commGroupName,
+		channels:            channelsByIDCfg,
+		botMentionRegex:     botMentionRegex,
+		mdFormatter:         interactive.DefaultMDFormatter(),
+		digests:             newEventDigests(log, notificationDigestHeader),
+		quietHoursSummaries: newEventDigests(log, quietHoursSummaryHeader),
+		interactivity:       interactivity,
+		eventActions:        eventActions,
 	}, nil
 }
 
@@ -146,6 +166,28 @@ func (b *Mattermost) Start(ctx context.Context) error {
 		return fmt.Errorf("while reporting analytics: %w", err)
 	}
 
+	// stays nil if interactivity is disabled, so receiving from it blocks forever
+	var interactivityErrs chan error
+	if b.interactivity.Enabled {
+		interactivityErrs = make(chan error, 1)
+		go func() {
+			defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+			if err := b.startInteractivityServer(ctx); err != nil {
+				interactivityErrs <- err
+			}
+		}()
+	}
+
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.digests.Run(ctx, b.getDigestChannels(), b.sendDigest)
+	}()
+
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.quietHoursSummaries.RunWhenReady(ctx, quietHoursCheckInterval, b.quietHoursOver, b.sendDigest)
+	}()
+
 	// It is observed that Mattermost server closes connections unexpectedly after some time.
 	// For now, we are adding retry logic to reconnect to the server
 	// https://github.com/kubeshop/botkube/issues/201
@@ -161,7 +203,9 @@ func (b *Mattermost) Start(ctx context.Context) error {
 			if appErr != nil {
 				return fmt.Errorf("while creating WebSocket connection: %w", appErr)
 			}
-			b.listen(ctx)
+			if err := b.listen(ctx, interactivityErrs); err != nil {
+				return err
+			}
 		}
 	}
 }
@@ -205,6 +249,40 @@ func (b *Mattermost) SetNotificationsEnabled(channelID string, enabled bool) err
 	return nil
 }
 
+// QuietHours returns quiet hours configured for a given channel ID.
+func (b *Mattermost) QuietHours(channelID string) config.QuietHours {
+	channel, exists := b.getChannels()[channelID]
+	if !exists {
+		return config.QuietHours{}
+	}
+
+	return channel.Notification.QuietHours
+}
+
+// SetQuietHours sets quiet hours for a given channel ID. Empty quiet hours disable them.
+func (b *Mattermost) SetQuietHours(channelID string, quietHours config.QuietHours) error {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	channels := b.getChannels()
+	channel, exists := channels[channelID]
+	if !exists {
+		return execute.ErrNotificationsNotConfigured
+	}
+
+	channel.Notification.QuietHours = quietHours
+	channels[channelID] = channel
+	b.setChannels(channels)
+
+	return nil
+}
+
+// quietHoursOver returns true if quiet hours are not active for a given channel ID.
+func (b *Mattermost) quietHoursOver(channelID string) bool {
+	return !quietHoursActive(b.log, b.QuietHours(channelID), time.Now())
+}
+
 // Check incoming message and take action
 func (mm *mattermostMessage) handleMessage(b *Mattermost) {
 	post, err := postFromEvent(mm.Event)
@@ -222,67 +300,93 @@ func (mm *mattermostMessage) handleMessage(b *Mattermost) {
 	mm.Request = trimmedMsg
 
 	channelID := mm.Event.GetBroadcast().ChannelId
-	channel, exists := b.getChannels()[channelID]
-	mm.IsAuthChannel = exists
+	_, mm.IsAuthChannel = b.getChannels()[channelID]
+
+	response := b.execute(channelID, post.UserId, mm.Request, false)
+	if err := b.sendResponse(channelID, mm.Request, response); err != nil {
+		mm.log.Errorf("while sending message: %s", err.Error())
+	}
+}
 
-	e := mm.executorFactory.NewDefault(execute.NewDefaultInput{
+func (b *Mattermost) execute(channelID, userID, req string, isButtonClickOrigin bool) interactive.Message {
+	channel, isAuthChannel := b.getChannels()[channelID]
+	conversationID := channel.Identifier()
+	if !isAuthChannel {
+		// keep the conversations which are not configured apart
+		conversationID = channelID
+	}
+
+	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
 		NotifierHandler: b,
 		Conversation: execute.Conversation{
-			Alias:            channel.alias,
-			ID:               channel.Identifier(),
-			ExecutorBindings: channel.Bindings.Executors,
-			IsAuthenticated:  mm.IsAuthChannel,
+			Alias:               channel.alias,
+			ID:                  conversationID,
+			ExecutorBindings:    channel.Bindings.Executors,
+			IsAuthenticated:     isAuthChannel,
+			IsButtonClickOrigin: isButtonClickOrigin,
 		},
-		Message: mm.Request,
+		Message: req,
+		User:    userID,
 	})
-	response := e.Execute()
-	mm.sendMessage(b, response)
+	return e.Execute()
 }
 
-// Send messages to Mattermost
-func (mm mattermostMessage) sendMessage(b *Mattermost, resp interactive.Message) {
-	mm.log.Debugf("Mattermost incoming Request: %s", mm.Request)
-	mm.log.Debugf("Mattermost Response: %s", resp)
+// sendResponse sends the executor response to a given Mattermost channel.
+func (b *Mattermost) sendResponse(channelID, req string, resp interactive.Message) error {
+	b.log.Debugf("Mattermost incoming Request: %s", req)
+	b.log.Debugf("Mattermost Response: %s", resp)
 
 	markdown := interactive.RenderMessage(b.mdFormatter, resp)
 
 	if len(markdown) == 0 {
-		mm.log.Infof("Invalid request. Dumping the response. Request: %s", mm.Request)
-		return
+		b.log.Infof("Invalid request. Dumping the response. Request: %s", req)
+		return nil
 	}
 
 	// Create file if message is too large
 	if len(markdown) >= mattermostMaxMessageSize {
-		uploadResponse, _, err := mm.APIClient.UploadFileAsRequestBody(
+		uploadResponse, _, err := b.apiClient.UploadFileAsRequestBody(
 			[]byte(interactive.MessageToPlaintext(resp, interactive.NewlineFormatter)),
-			mm.Event.GetBroadcast().ChannelId,
-			mm.Request,
+			channelID,
+			req,
 		)
 		if err != nil {
-			mm.log.Error("Error occurred while uploading file. Error: ", err)
-			return
+			return fmt.Errorf("while uploading file: %w", err)
 		}
 
 		post := &model.Post{}
-		post.ChannelId = mm.Event.GetBroadcast().ChannelId
+		post.ChannelId = channelID
 		post.Message = resp.Description
 		post.FileIds = []string{uploadResponse.FileInfos[0].Id}
 
-		if _, _, err := mm.APIClient.CreatePost(post); err != nil {
-			mm.log.Error("Failed to send attachment message. Error: ", err)
-			return
+		if _, _, err := b.apiClient.CreatePost(post); err != nil {
+			return fmt.Errorf("while sending attachment message: %w", err)
 		}
-		return
+		return nil
 	}
 
-	post := &model.Post{}
-	post.ChannelId = mm.Event.GetBroadcast().ChannelId
-	post.Message = markdown
-	if _, _, err := mm.APIClient.CreatePost(post); err != nil {
-		mm.log.Error("Failed to send message. Error: ", err)
+	if _, _, err := b.apiClient.CreatePost(b.newPost(channelID, markdown, resp)); err != nil {
+		return fmt.Errorf("while sending message: %w", err)
 	}
+	return nil
+}
+
+// newPost creates a post with interactive attachments, if they are enabled.
+func (b *Mattermost) newPost(channelID, markdown string, msg interactive.Message) *model.Post {
+	post := &model.Post{
+		ChannelId: channelID,
+		Message:   markdown,
+	}
+
+	if attachments := b.renderAttachments(msg); len(attachments) > 0 {
+		post.Props = map[string]interface{}{
+			"attachments": attachments,
+		}
+	}
+
+	return post
 }
 
 // Check if Mattermost server is reachable
@@ -318,14 +422,18 @@ func (b *Mattermost) getUser() *model.User {
 	return users.Users[0]
 }
 
-func (b *Mattermost) listen(ctx context.Context) {
+// listen handles incoming WebSocket events until the connection is closed.
+// It returns an error if the interactivity server fails, as interactive messages cannot be handled anymore.
+func (b *Mattermost) listen(ctx context.Context, interactivityErrs <-chan error) error {
 	b.wsClient.Listen()
 	defer b.wsClient.Close()
 	for {
 		select {
 		case <-ctx.Done():
 			b.log.Info("Shutdown requested. Finishing...")
-			return
+			return nil
+		case err := <-interactivityErrs:
+			return err
 		case event, ok := <-b.wsClient.EventChannel:
 			if !ok {
 				if b.wsClient.ListenError != nil {
@@ -333,7 +441,7 @@ func (b *Mattermost) listen(ctx context.Context) {
 				}
 
 				b.log.Info("Incoming events channel closed. Finishing...")
-				return
+				return nil
 			}
 
 			if event == nil {
@@ -356,11 +464,9 @@ func (b *Mattermost) listen(ctx context.Context) {
 				continue
 			}
 			mm := mattermostMessage{
-				log:             b.log,
-				executorFactory: b.executorFactory,
-				Event:           event,
-				IsAuthChannel:   false,
-				APIClient:       b.apiClient,
+				log:           b.log,
+				Event:         event,
+				IsAuthChannel: false,
 			}
 			mm.handleMessage(b)
 		}
@@ -370,10 +476,15 @@ func (b *Mattermost) listen(ctx context.Context) {
 // SendEvent sends event notification to Mattermost
 func (b *Mattermost) SendEvent(_ context.Context, event events.Event, eventSources []string) error {
 	b.log.Debugf("Sending to Mattermost: %+v", event)
-	attachment := b.formatAttachments(event)
-
 	errs := multierror.New()
 	for _, channelID := range b.getChannelsToNotify(event, eventSources) {
+		channel, found := b.getChannels()[channelID]
+		if found && deferEvent(b.log, b.digests, b.quietHoursSummaries, channelID, channel.Notification, event) {
+			continue
+		}
+
+		attachment := b.formatAttachments(event.ForSources(channel.Bindings.Sources), channel.Notification.Template)
+		attachment = append(attachment, b.renderEventActions(event, channel.Bindings.Executors)...)
 		post := &model.Post{
 			Props: map[string]interface{}{
 				"attachments": attachment,
@@ -403,10 +514,11 @@ func (b *Mattermost) getChannelsToNotify(event events.Event, eventSources []stri
 		switch {
 		case !cfg.notify:
 			b.log.Infof("Skipping notification for channel %q as notifications are disabled.", cfg.Identifier())
+		case !sliceutil.Intersect(eventSources, cfg.Bindings.Sources):
+		case !event.Level.IsAtLeast(cfg.Bindings.MinLevel):
+			b.log.Debugf("Skipping notification for channel %q as the event level %q is lower than %q.", cfg.Identifier(), event.Level, cfg.Bindings.MinLevel)
 		default:
-			if sliceutil.Intersect(eventSources, cfg.Bindings.Sources) {
-				out = append(out, cfg.Identifier())
-			}
+			out = append(out, cfg.Identifier())
 		}
 	}
 	return out
@@ -419,10 +531,7 @@ func (b *Mattermost) SendMessage(_ context.Context, msg interactive.Message) err
 		channelID := channel.ID
 		plaintext := interactive.RenderMessage(b.mdFormatter, msg)
 		b.log.Debugf("Sending message to channel %q: %+v", channelID, plaintext)
-		post := &model.Post{
-			ChannelId: channelID,
-			Message:   plaintext,
-		}
+		post := b.newPost(channelID, plaintext, msg)
 		if _, _, err := b.apiClient.CreatePost(post); err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while creating a post: %w", err))
 		}
@@ -432,6 +541,41 @@ func (b *Mattermost) SendMessage(_ context.Context, msg interactive.Message) err
 	return errs.ErrorOrNil()
 }
 
+// SendScheduledReport sends a scheduled report to Mattermost channels bound to a given schedule.
+func (b *Mattermost) SendScheduledReport(_ context.Context, scheduleName string, render schedule.ReportRenderer) error {
+	errs := multierror.New()
+	for _, channel := range b.getChannels() {
+		if !sliceutil.Intersect([]string{scheduleName}, channel.Bindings.Schedules) {
+			continue
+		}
+
+		msg := render(schedule.Channel{Alias: channel.alias, ID: channel.ID, Bindings: channel.Bindings})
+		if err := b.sendResponse(channel.ID, scheduleName, msg); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending scheduled report to channel %q: %w", channel.ID, err))
+			continue
+		}
+		b.log.Debugf("Scheduled report %q successfully sent to channel %q", scheduleName, channel.ID)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// getDigestChannels returns digest configuration for channels with the digest notification mode.
+func (b *Mattermost) getDigestChannels() map[string]config.ChannelDigest {
+	out := map[string]config.ChannelDigest{}
+	for _, cfg := range b.getChannels() {
+		if cfg.Notification.IsDigest() {
+			out[cfg.Identifier()] = cfg.Notification.Digest
+		}
+	}
+	return out
+}
+
+// sendDigest sends the notification digest to a given Mattermost channel.
+func (b *Mattermost) sendDigest(_ context.Context, channelID string, msg interactive.Message) error {
+	return b.sendResponse(channelID, "digest", msg)
+}
+
 // BotName returns the Bot name.
 func (b *Mattermost) BotName() string {
 	return fmt.Sprintf("@%s", b.botName)
@@ -445,6 +589,15 @@ func (b *Mattermost) findAndTrimBotMention(msg string) (string, bool) {
 	return b.botMentionRegex.ReplaceAllString(msg, ""), true
 }
 
+// SetBindings sets bindings for already configured channels without restart.
+func (b *Mattermost) SetBindings(commGroup config.Communications) {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	b.setChannels(channelsByIDWithBindings(b.getChannels(), bindingsByAliasFromNames(commGroup.Mattermost.Channels)))
+}
+
 func (b *Mattermost) getChannels() map[string]channelConfigByID {
 	b.channelsMutex.RLock()
 	defer b.channelsMutex.RUnlock()
@@ -4,20 +4,28 @@ import (
 	"encoding/json"
 	"strconv"
 
-	"github.com/mattermost/mattermost-server/v5/model"
+	"github.com/mattermost/mattermost-server/v6/model"
 
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	formatx "github.com/kubeshop/botkube/pkg/format"
 )
 
-func (b *Mattermost) formatAttachments(event events.Event) []*model.SlackAttachment {
+func (b *Mattermost) formatAttachments(event events.Event, channelTemplate config.EventTemplate) []*model.SlackAttachment {
+	title := event.Title
 	var fields []*model.SlackAttachmentField
-	switch b.notification.Type {
-	case config.LongNotification:
+
+	custom, isCustom := renderCustomEventMessage(b.log, b.IntegrationName(), channelTemplate, event)
+	switch {
+	case isCustom:
+		title = custom.Title
+		fields = []*model.SlackAttachmentField{
+			{
+				Value: custom.Body,
+			},
+		}
+	case b.notification.Type == config.LongNotification:
 		fields = b.longNotification(event)
-	case config.ShortNotification:
-		fallthrough
 	default:
 		// set missing cluster name to the event object
 		fields = b.shortNotification(event)
@@ -26,7 +34,7 @@ func (b *Mattermost) formatAttachments(event events.Event) []*model.SlackAttachm
 	return []*model.SlackAttachment{
 		{
 			Color:     attachmentColor[event.Level],
-			Title:     event.Title,
+			Title:     title,
 			Fields:    fields,
 			Footer:    "BotKube",
 			Timestamp: json.Number(strconv.FormatInt(event.TimeStamp.Unix(), 10)),
@@ -49,11 +57,15 @@ func (b *Mattermost) longNotification(event events.Event) []*model.SlackAttachme
 	}
 
 	fields = b.appendIfNotEmpty(fields, event.Namespace, "Namespace", true)
+	fields = b.appendIfNotEmpty(fields, event.Workload(), "Workload", true)
+	fields = b.appendIfNotEmpty(fields, event.Related(), "Related", true)
 	fields = b.appendIfNotEmpty(fields, event.Reason, "Reason", true)
 	fields = b.appendIfNotEmpty(fields, formatx.JoinMessages(event.Messages), "Message", false)
 	fields = b.appendIfNotEmpty(fields, event.Action, "Action", true)
 	fields = b.appendIfNotEmpty(fields, formatx.JoinMessages(event.Recommendations), "Recommendations", false)
 	fields = b.appendIfNotEmpty(fields, formatx.JoinMessages(event.Warnings), "Warnings", false)
+	fields = b.appendIfNotEmpty(fields, formatx.OptionalCodeBlock(event.Diff), "Diff", false)
+	fields = b.appendIfNotEmpty(fields, formatx.OptionalCodeBlock(event.Logs), "Logs", false)
 	fields = b.appendIfNotEmpty(fields, event.Cluster, "Cluster", false)
 
 	return fields
@@ -0,0 +1,352 @@
+package bot
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/mattermost/mattermost-server/v6/model"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/httpsrv"
+)
+
+const (
+	mattermostDefaultInteractivityPort = "2114"
+	mattermostActionsPath              = "/mattermost/actions"
+	mattermostDialogsPath              = "/mattermost/dialogs"
+
+	// mattermostCommandContextKey holds the command executed when a given button is clicked.
+	mattermostCommandContextKey = "command"
+	// mattermostMultiSelectContextKey holds the JSON-encoded multi select rendered as a dialog when a given button is clicked.
+	mattermostMultiSelectContextKey = "multiSelect"
+	// mattermostSecretContextKey holds the secret which authenticates requests sent by Mattermost when a given button is clicked.
+	mattermostSecretContextKey = "secret"
+
+	mattermostInteractivitySecretBytes = 32
+
+	mattermostDialogElementTypeBool = "bool"
+	mattermostPostActionTypeButton  = "button"
+)
+
+// mattermostDialogState is passed to the interactive dialog and sent back by Mattermost on submission.
+// As the dialog state is visible for the user, it holds a signature of the channel and command instead of the secret itself.
+type mattermostDialogState struct {
+	Command   string `json:"command"`
+	Signature string `json:"signature"`
+}
+
+var mattermostButtonStyle = map[interactive.ButtonStyle]string{
+	interactive.ButtonStyleDefault: "default",
+	interactive.ButtonStylePrimary: "primary",
+	interactive.ButtonStyleDanger:  "danger",
+}
+
+// startInteractivityServer starts the integration endpoint used by Mattermost interactive buttons and dialogs.
+func (b *Mattermost) startInteractivityServer(ctx context.Context) error {
+	router := mux.NewRouter()
+	router.HandleFunc(mattermostActionsPath, b.handleAction).Methods(http.MethodPost)
+	router.HandleFunc(mattermostDialogsPath, b.handleDialogSubmission).Methods(http.MethodPost)
+
+	addr := fmt.Sprintf(":%s", b.interactivity.Port)
+	srv := httpsrv.New(b.log, addr, router)
+	err := srv.Serve(ctx)
+	if err != nil {
+		return fmt.Errorf("while running Mattermost interactivity server: %w", err)
+	}
+
+	return nil
+}
+
+// handleAction handles the Mattermost post action requests.
+func (b *Mattermost) handleAction(w http.ResponseWriter, req *http.Request) {
+	var in model.PostActionIntegrationRequest
+	if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+		b.log.Errorf("while decoding post action request: %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	secret, _ := in.Context[mattermostSecretContextKey].(string)
+	if !b.isInteractivitySecretValid(secret) {
+		b.log.Errorf("Rejecting post action from user %q in channel %q with invalid secret", in.UserId, in.ChannelId)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if rawMultiSelect, ok := in.Context[mattermostMultiSelectContextKey].(string); ok {
+		var ms interactive.MultiSelect
+		if err := json.Unmarshal([]byte(rawMultiSelect), &ms); err != nil {
+			b.log.Errorf("while decoding multi select: %s", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := b.openMultiSelectDialog(in.ChannelId, in.TriggerId, ms); err != nil {
+			b.log.Errorf("while opening dialog: %s", err.Error())
+		}
+		b.writeJSON(w, model.PostActionIntegrationResponse{})
+		return
+	}
+
+	cmd, ok := in.Context[mattermostCommandContextKey].(string)
+	if !ok {
+		b.log.Debugf("Ignoring post action without command: %+v", in.Context)
+		b.writeJSON(w, model.PostActionIntegrationResponse{})
+		return
+	}
+
+	if err := b.handleInteraction(in.ChannelId, in.UserId, in.TriggerId, cmd); err != nil {
+		b.log.Errorf("Interaction handling error: %s", err.Error())
+	}
+	b.writeJSON(w, model.PostActionIntegrationResponse{})
+}
+
+// handleDialogSubmission handles the Mattermost interactive dialog submissions.
+// The dialog state holds the multi select command, and the selected options are appended as a comma separated list.
+func (b *Mattermost) handleDialogSubmission(w http.ResponseWriter, req *http.Request) {
+	var in model.SubmitDialogRequest
+	if err := json.NewDecoder(req.Body).Decode(&in); err != nil {
+		b.log.Errorf("while decoding dialog submission: %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var state mattermostDialogState
+	if err := json.Unmarshal([]byte(in.State), &state); err != nil {
+		b.log.Errorf("while decoding dialog state: %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !b.isDialogStateValid(in.ChannelId, state) {
+		b.log.Errorf("Rejecting dialog submission from user %q in channel %q with invalid secret", in.UserId, in.ChannelId)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	if in.Cancelled {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	var selected []string
+	for name, value := range in.Submission {
+		if isSelected, _ := value.(bool); isSelected {
+			selected = append(selected, name)
+		}
+	}
+	sort.Strings(selected)
+
+	cmd := fmt.Sprintf("%s %s", state.Command, strings.Join(selected, ","))
+	if err := b.handleInteraction(in.ChannelId, in.UserId, "", cmd); err != nil {
+		b.log.Errorf("Interaction handling error: %s", err.Error())
+	}
+	b.writeJSON(w, model.SubmitDialogResponse{})
+}
+
+// handleInteraction executes a given command and sends the response.
+// Popup responses are opened as dialogs if a trigger ID is available.
+func (b *Mattermost) handleInteraction(channelID, userID, triggerID, cmd string) error {
+	req := strings.TrimSpace(b.botMentionRegex.ReplaceAllString(cmd, ""))
+	response := b.execute(channelID, userID, req, true)
+
+	if response.Type == interactive.Popup && triggerID != "" {
+		for _, section := range response.Sections {
+			if !section.MultiSelect.AreOptionsDefined() {
+				continue
+			}
+			return b.openMultiSelectDialog(channelID, triggerID, section.MultiSelect)
+		}
+	}
+
+	return b.sendResponse(channelID, req, response)
+}
+
+func (b *Mattermost) openMultiSelectDialog(channelID, triggerID string, ms interactive.MultiSelect) error {
+	selected := map[string]struct{}{}
+	for _, opt := range ms.InitialOptions {
+		selected[opt.Value] = struct{}{}
+	}
+
+	var elements []model.DialogElement
+	for _, opt := range ms.Options {
+		_, isSelected := selected[opt.Value]
+		elements = append(elements, model.DialogElement{
+			DisplayName: opt.Name,
+			Name:        opt.Value,
+			Type:        mattermostDialogElementTypeBool,
+			Default:     strconv.FormatBool(isSelected),
+			Optional:    true,
+		})
+	}
+
+	state, err := json.Marshal(mattermostDialogState{
+		Command:   ms.Command,
+		Signature: b.dialogSignature(channelID, ms.Command),
+	})
+	if err != nil {
+		return fmt.Errorf("while marshaling dialog state: %w", err)
+	}
+
+	_, err = b.apiClient.OpenInteractiveDialog(model.OpenDialogRequest{
+		TriggerId: triggerID,
+		URL:       b.interactivityURL(mattermostDialogsPath),
+		Dialog: model.Dialog{
+			Title:            ms.Name,
+			IntroductionText: ms.Description.Plaintext,
+			Elements:         elements,
+			SubmitLabel:      "Submit",
+			State:            string(state),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("while opening interactive dialog: %w", err)
+	}
+
+	return nil
+}
+
+// renderAttachments converts interactive message sections into Mattermost message attachments with actions.
+func (b *Mattermost) renderAttachments(msg interactive.Message) []*model.SlackAttachment {
+	if !b.interactivity.Enabled {
+		return nil
+	}
+
+	var attachments []*model.SlackAttachment
+	for _, section := range msg.Sections {
+		var (
+			actions []*model.PostAction
+			links   []string
+		)
+
+		if section.MultiSelect.AreOptionsDefined() {
+			action, err := b.multiSelectAction(section.MultiSelect)
+			if err != nil {
+				b.log.Errorf("while rendering multi select: %s", err.Error())
+			} else {
+				actions = append(actions, action)
+			}
+		}
+
+		for _, btn := range section.Buttons {
+			switch {
+			case btn.URL != "":
+				// URL buttons are not supported by Mattermost, render them as links instead.
+				links = append(links, fmt.Sprintf("[%s](%s)", btn.Name, btn.URL))
+			case btn.Command != "":
+				actions = append(actions, &model.PostAction{
+					Type:  mattermostPostActionTypeButton,
+					Name:  btn.Name,
+					Style: mattermostButtonStyle[btn.Style],
+					Integration: &model.PostActionIntegration{
+						URL: b.interactivityURL(mattermostActionsPath),
+						Context: map[string]interface{}{
+							mattermostCommandContextKey: btn.Command,
+							mattermostSecretContextKey:  b.interactivity.Secret,
+						},
+					},
+				})
+			}
+		}
+
+		if len(actions) == 0 && len(links) == 0 {
+			continue
+		}
+
+		attachments = append(attachments, &model.SlackAttachment{
+			Text:    strings.Join(links, " "),
+			Actions: actions,
+		})
+	}
+
+	return attachments
+}
+
+// renderEventActions returns attachments with action buttons allowed for a given event.
+func (b *Mattermost) renderEventActions(event events.Event, executorBindings []string) []*model.SlackAttachment {
+	buttons := b.eventActions.ForEvent(event, executorBindings, b.BotName())
+	if len(buttons) == 0 {
+		return nil
+	}
+
+	return b.renderAttachments(interactive.Message{
+		Sections: []interactive.Section{
+			{Buttons: buttons},
+		},
+	})
+}
+
+func (b *Mattermost) multiSelectAction(ms interactive.MultiSelect) (*model.PostAction, error) {
+	raw, err := json.Marshal(ms)
+	if err != nil {
+		return nil, fmt.Errorf("while marshaling multi select: %w", err)
+	}
+
+	return &model.PostAction{
+		Type:  mattermostPostActionTypeButton,
+		Name:  ms.Name,
+		Style: mattermostButtonStyle[interactive.ButtonStylePrimary],
+		Integration: &model.PostActionIntegration{
+			URL: b.interactivityURL(mattermostActionsPath),
+			Context: map[string]interface{}{
+				mattermostMultiSelectContextKey: string(raw),
+				mattermostSecretContextKey:      b.interactivity.Secret,
+			},
+		},
+	}, nil
+}
+
+// isInteractivitySecretValid returns true if a given secret matches the one attached to buttons and dialogs.
+func (b *Mattermost) isInteractivitySecretValid(secret string) bool {
+	if b.interactivity.Secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(b.interactivity.Secret)) == 1
+}
+
+// isDialogStateValid returns true if a given dialog state was signed for a given channel.
+func (b *Mattermost) isDialogStateValid(channelID string, state mattermostDialogState) bool {
+	if b.interactivity.Secret == "" {
+		return false
+	}
+	return hmac.Equal([]byte(state.Signature), []byte(b.dialogSignature(channelID, state.Command)))
+}
+
+// dialogSignature returns the signature which binds a given dialog command with a given channel.
+func (b *Mattermost) dialogSignature(channelID, cmd string) string {
+	mac := hmac.New(sha256.New, []byte(b.interactivity.Secret))
+	mac.Write([]byte(channelID + "\n" + cmd))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// newMattermostInteractivitySecret returns a random secret used to authenticate Mattermost interactivity requests.
+func newMattermostInteractivitySecret() (string, error) {
+	raw := make([]byte, mattermostInteractivitySecretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("while generating Mattermost interactivity secret: %w", err)
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+func (b *Mattermost) interactivityURL(path string) string {
+	return strings.TrimSuffix(b.interactivity.URL, "/") + path
+}
+
+func (b *Mattermost) writeJSON(w http.ResponseWriter, in interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(in); err != nil {
+		b.log.Errorf("while writing response: %s", err.Error())
+	}
+}
@@ -1,10 +1,20 @@
 package bot
 
 import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/mattermost/mattermost-server/v6/model"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
 )
 
 func TestMattermost_FindAndTrimBotMention(t *testing.T) {
@@ -62,3 +72,283 @@ func TestMattermost_FindAndTrimBotMention(t *testing.T) {
 		})
 	}
 }
+
+func TestMattermost_RenderAttachments(t *testing.T) {
+	// given
+	ms := interactive.MultiSelect{
+		Name:    "Adjust notifications",
+		Command: "@BotKube edit SourceBindings",
+		Options: []interactive.OptionItem{
+			{Name: "K8s errors", Value: "k8s-err-events"},
+		},
+	}
+	msg := interactive.Message{
+		Sections: []interactive.Section{
+			{
+				MultiSelect: ms,
+			},
+			{
+				Buttons: interactive.Buttons{
+					{Name: "Stop notifications", Command: "@BotKube notifier stop", Style: interactive.ButtonStyleDanger},
+					{Name: "Docs", URL: "https://botkube.io/docs"},
+				},
+			},
+		},
+	}
+
+	t.Run("Interactivity disabled", func(t *testing.T) {
+		b := &Mattermost{log: logrus.New()}
+
+		// when
+		attachments := b.renderAttachments(msg)
+
+		// then
+		assert.Empty(t, attachments)
+	})
+
+	t.Run("Interactivity enabled", func(t *testing.T) {
+		b := &Mattermost{
+			log: logrus.New(),
+			interactivity: config.MattermostInteractivity{
+				Enabled: true,
+				URL:     "http://botkube.botkube:2114/",
+				Secret:  "secret",
+			},
+		}
+
+		// when
+		attachments := b.renderAttachments(msg)
+
+		// then
+		require.Len(t, attachments, 2)
+
+		require.Len(t, attachments[0].Actions, 1)
+		msAction := attachments[0].Actions[0]
+		assert.Equal(t, "Adjust notifications", msAction.Name)
+		assert.Equal(t, "http://botkube.botkube:2114/mattermost/actions", msAction.Integration.URL)
+		var actualMS interactive.MultiSelect
+		require.NoError(t, json.Unmarshal([]byte(msAction.Integration.Context[mattermostMultiSelectContextKey].(string)), &actualMS))
+		assert.Equal(t, ms, actualMS)
+		assert.Equal(t, "secret", msAction.Integration.Context[mattermostSecretContextKey])
+
+		assert.Equal(t, "[Docs](https://botkube.io/docs)", attachments[1].Text)
+		assert.Equal(t, []*model.PostAction{
+			{
+				Type:  "button",
+				Name:  "Stop notifications",
+				Style: "danger",
+				Integration: &model.PostActionIntegration{
+					URL: "http://botkube.botkube:2114/mattermost/actions",
+					Context: map[string]interface{}{
+						"command": "@BotKube notifier stop",
+						"secret":  "secret",
+					},
+				},
+			},
+		}, attachments[1].Actions)
+	})
+}
+
+func TestMattermost_GetChannelsToNotifyRespectsMinLevel(t *testing.T) {
+	// given
+	channel := func(id string, minLevel config.Level) channelConfigByID {
+		return channelConfigByID{
+			ChannelBindingsByID: config.ChannelBindingsByID{
+				ID: id,
+				Bindings: config.BotBindings{
+					Sources:  []string{"k8s-events"},
+					MinLevel: minLevel,
+				},
+			},
+			notify: true,
+		}
+	}
+	b := &Mattermost{
+		log: logrus.New(),
+		channels: map[string]channelConfigByID{
+			"alerts": channel("alerts", config.Error),
+			"audit":  channel("audit", ""),
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Level    config.Level
+		Expected []string
+	}{
+		{
+			Name:     "Error event",
+			Level:    config.Error,
+			Expected: []string{"alerts", "audit"},
+		},
+		{
+			Name:     "Critical event",
+			Level:    config.Critical,
+			Expected: []string{"alerts", "audit"},
+		},
+		{
+			Name:     "Info event",
+			Level:    config.Info,
+			Expected: []string{"audit"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// when
+			actual := b.getChannelsToNotify(events.Event{Level: tc.Level}, []string{"k8s-events"})
+
+			// then
+			assert.ElementsMatch(t, tc.Expected, actual)
+		})
+	}
+}
+
+func TestMattermost_HandleActionVerifiesSecret(t *testing.T) {
+	tests := []struct {
+		name string
+
+		secret string
+
+		expStatus   int
+		expExecuted []string
+	}{
+		{
+			name:        "Valid secret",
+			secret:      "secret",
+			expStatus:   http.StatusOK,
+			expExecuted: []string{"notifier stop"},
+		},
+		{
+			name:      "Invalid secret",
+			secret:    "guessed",
+			expStatus: http.StatusForbidden,
+		},
+		{
+			name:      "Missing secret",
+			expStatus: http.StatusForbidden,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			executors := &fakeExecutorFactory{}
+			b := fixMattermostWithAPI(t, executors)
+
+			ctx := map[string]interface{}{mattermostCommandContextKey: "@BotKube notifier stop"}
+			if tc.secret != "" {
+				ctx[mattermostSecretContextKey] = tc.secret
+			}
+			body, err := json.Marshal(model.PostActionIntegrationRequest{
+				UserId:    "user-id",
+				ChannelId: "channel-id",
+				Context:   ctx,
+			})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+
+			// when
+			b.handleAction(rec, httptest.NewRequest(http.MethodPost, mattermostActionsPath, bytes.NewReader(body)))
+
+			// then
+			assert.Equal(t, tc.expStatus, rec.Code)
+
+			var executed []string
+			for _, in := range executors.inputs {
+				assert.Equal(t, "user-id", in.User)
+				executed = append(executed, in.Message)
+			}
+			assert.Equal(t, tc.expExecuted, executed)
+		})
+	}
+}
+
+func TestMattermost_HandleDialogSubmissionVerifiesSignature(t *testing.T) {
+	tests := []struct {
+		name string
+
+		signedChannelID string
+		channelID       string
+
+		expStatus   int
+		expExecuted []string
+	}{
+		{
+			name:            "Signed for the channel",
+			signedChannelID: "channel-id",
+			channelID:       "channel-id",
+			expStatus:       http.StatusOK,
+			expExecuted:     []string{"edit SourceBindings k8s-err-events"},
+		},
+		{
+			name:            "Signed for a different channel",
+			signedChannelID: "channel-id",
+			channelID:       "other-channel-id",
+			expStatus:       http.StatusForbidden,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			executors := &fakeExecutorFactory{}
+			b := fixMattermostWithAPI(t, executors)
+
+			cmd := "@BotKube edit SourceBindings"
+			state, err := json.Marshal(mattermostDialogState{
+				Command:   cmd,
+				Signature: b.dialogSignature(tc.signedChannelID, cmd),
+			})
+			require.NoError(t, err)
+
+			body, err := json.Marshal(model.SubmitDialogRequest{
+				UserId:     "user-id",
+				ChannelId:  tc.channelID,
+				State:      string(state),
+				Submission: map[string]interface{}{"k8s-err-events": true, "k8s-all-events": false},
+			})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+
+			// when
+			b.handleDialogSubmission(rec, httptest.NewRequest(http.MethodPost, mattermostDialogsPath, bytes.NewReader(body)))
+
+			// then
+			assert.Equal(t, tc.expStatus, rec.Code)
+
+			var executed []string
+			for _, in := range executors.inputs {
+				assert.Equal(t, "user-id", in.User)
+				executed = append(executed, in.Message)
+			}
+			assert.Equal(t, tc.expExecuted, executed)
+		})
+	}
+}
+
+func fixMattermostWithAPI(t *testing.T, executors ExecutorFactory) *Mattermost {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte("{}"))
+	}))
+	t.Cleanup(srv.Close)
+
+	botMentionRegex, err := mattermostBotMentionRegex("BotKube")
+	require.NoError(t, err)
+
+	return &Mattermost{
+		log:             logrus.New(),
+		executorFactory: executors,
+		apiClient:       model.NewAPIv4Client(srv.URL),
+		botMentionRegex: botMentionRegex,
+		mdFormatter:     interactive.DefaultMDFormatter(),
+		interactivity: config.MattermostInteractivity{
+			Enabled: true,
+			URL:     "http://botkube.botkube:2114",
+			Secret:  "secret",
+		},
+	}
+}
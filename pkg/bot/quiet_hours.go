@@ -0,0 +1,31 @@
+package bot
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+// quietHoursActive returns true if quiet hours are active at a given time. Misconfigured quiet hours are considered inactive.
+func quietHoursActive(log logrus.FieldLogger, quietHours config.QuietHours, now time.Time) bool {
+	active, err := quietHours.IsActive(now)
+	if err != nil {
+		log.Errorf("while checking quiet hours: %s. Ignoring them...", err.Error())
+		return false
+	}
+
+	return active
+}
+
+// holdBackDuringQuietHours returns true if a given event should be held back until the quiet hours are over.
+// Events with at least the minimum quiet hours level are always sent.
+func holdBackDuringQuietHours(log logrus.FieldLogger, quietHours config.QuietHours, event events.Event, now time.Time) bool {
+	if event.Level.IsAtLeast(quietHours.MinLevelOrDefault()) {
+		return false
+	}
+
+	return quietHoursActive(log, quietHours, now)
+}
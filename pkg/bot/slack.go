@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
@@ -15,6 +16,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/schedule"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -38,19 +40,21 @@ var attachmentColor = map[config.Level]string{
 
 // Slack listens for user's message, execute commands and sends back the response.
 type Slack struct {
-	log             logrus.FieldLogger
-	executorFactory ExecutorFactory
-	reporter        FatalErrorAnalyticsReporter
-	botID           string
-	client          *slack.Client
-	notification    config.Notification
-	channelsMutex   sync.RWMutex
-	channels        map[string]channelConfigByName
-	notifyMutex     sync.Mutex
-	botMentionRegex *regexp.Regexp
-	commGroupName   string
-	renderer        *SlackRenderer
-	mdFormatter     interactive.MDFormatter
+	log                 logrus.FieldLogger
+	executorFactory     ExecutorFactory
+	reporter            FatalErrorAnalyticsReporter
+	botID               string
+	client              *slack.Client
+	notification        config.Notification
+	channelsMutex       sync.RWMutex
+	channels            map[string]channelConfigByName
+	notifyMutex         sync.Mutex
+	botMentionRegex     *regexp.Regexp
+	commGroupName       string
+	renderer            *SlackRenderer
+	mdFormatter         interactive.MDFormatter
+	digests             *eventDigests
+	quietHoursSummaries *eventDigests
 }
 
 // slackMessage contains message details to execute command and send back the result
@@ -83,17 +87,19 @@ func NewSlack(log logrus.FieldLogger, commGroupName string, cfg config.Slack, ex
 
 	mdFormatter := interactive.NewMDFormatter(interactive.NewlineFormatter, mdHeaderFormatter)
 	return &Slack{
-		log:             log,
-		executorFactory: executorFactory,
-		reporter:        reporter,
-		botID:           botID,
-		client:          client,
-		notification:    cfg.Notification,
-		channels:        channels,
-		commGroupName:   commGroupName,
-		renderer:        NewSlackRenderer(cfg.Notification),
-		botMentionRegex: botMentionRegex,
-		mdFormatter:     mdFormatter,
+		log:                 log,
+		executorFactory:     executorFactory,
+		reporter:            reporter,
+		botID:               botID,
+		client:              client,
+		notification:        cfg.Notification,
+		channels:            channels,
+		commGroupName:       commGroupName,
+		renderer:            NewSlackRenderer(cfg.Notification),
+		botMentionRegex:     botMentionRegex,
+		mdFormatter:         mdFormatter,
+		digests:             newEventDigests(log, notificationDigestHeader),
+		quietHoursSummaries: newEventDigests(log, quietHoursSummaryHeader),
 	}, nil
 }
 
@@ -107,6 +113,16 @@ func (b *Slack) Start(ctx context.Context) error {
 		rtm.ManageConnection()
 	}()
 
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.digests.Run(ctx, b.getDigestChannels(), b.sendDigest)
+	}()
+
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.quietHoursSummaries.RunWhenReady(ctx, quietHoursCheckInterval, b.quietHoursOver, b.sendDigest)
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -208,6 +224,40 @@ func (b *Slack) SetNotificationsEnabled(channelName string, enabled bool) error
 	return nil
 }
 
+// QuietHours returns quiet hours configured for a given channel name.
+func (b *Slack) QuietHours(channelName string) config.QuietHours {
+	channel, exists := b.getChannels()[channelName]
+	if !exists {
+		return config.QuietHours{}
+	}
+
+	return channel.Notification.QuietHours
+}
+
+// SetQuietHours sets quiet hours for a given channel name. Empty quiet hours disable them.
+func (b *Slack) SetQuietHours(channelName string, quietHours config.QuietHours) error {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	channels := b.getChannels()
+	channel, exists := channels[channelName]
+	if !exists {
+		return execute.ErrNotificationsNotConfigured
+	}
+
+	channel.Notification.QuietHours = quietHours
+	channels[channelName] = channel
+	b.setChannels(channels)
+
+	return nil
+}
+
+// quietHoursOver returns true if quiet hours are not active for a given channel name.
+func (b *Slack) quietHoursOver(channelName string) bool {
+	return !quietHoursActive(b.log, b.QuietHours(channelName), time.Now())
+}
+
 func (b *Slack) handleMessage(msg slackMessage) error {
 	// Handle message only if starts with mention
 	request, found := b.findAndTrimBotMention(msg.Text)
@@ -226,6 +276,11 @@ func (b *Slack) handleMessage(msg slackMessage) error {
 	}
 
 	channel, isAuthChannel := b.getChannels()[info.Name]
+	conversationID := channel.Identifier()
+	if !isAuthChannel {
+		// keep the conversations which are not configured apart
+		conversationID = info.Name
+	}
 
 	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
 		CommGroupName:   b.commGroupName,
@@ -233,7 +288,7 @@ func (b *Slack) handleMessage(msg slackMessage) error {
 		NotifierHandler: b,
 		Conversation: execute.Conversation{
 			Alias:            channel.alias,
-			ID:               channel.Identifier(),
+			ID:               conversationID,
 			ExecutorBindings: channel.Bindings.Executors,
 			IsAuthenticated:  isAuthChannel,
 		},
@@ -287,16 +342,29 @@ func (b *Slack) send(msg slackMessage, req string, resp interactive.Message, onl
 // SendEvent sends event notification to slack
 func (b *Slack) SendEvent(ctx context.Context, event events.Event, eventSources []string) error {
 	b.log.Debugf("Sending to Slack: %+v", event)
-	attachment := b.renderer.RenderEventMessage(event)
 
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(event, eventSources) {
+		if channel, found := b.getChannels()[channelName]; found && deferEvent(b.log, b.digests, b.quietHoursSummaries, channelName, channel.Notification, event) {
+			continue
+		}
+
+		channelEvent := event.ForSources(b.getChannels()[channelName].Bindings.Sources)
+		attachment, logs := renderEventMessageWithLogs(channelEvent, func(event events.Event) slack.Attachment {
+			return b.renderEventMessage(channelName, event)
+		})
 		channelID, timestamp, err := b.client.PostMessageContext(ctx, channelName, slack.MsgOptionAttachments(attachment), slack.MsgOptionAsUser(true))
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while posting message to channel %q: %w", channelName, err))
 			continue
 		}
 
+		if logs != "" {
+			if err := uploadEventLogsToSlack(ctx, b.client, channelID, timestamp, event, logs); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("while uploading logs to channel %q: %w", channelName, err))
+			}
+		}
+
 		b.log.Debugf("Event successfully sent to channel %q (ID: %q) at %b", channelName, channelID, timestamp)
 	}
 
@@ -320,11 +388,26 @@ func (b *Slack) getChannelsToNotify(event events.Event, eventSources []string) [
 			continue
 		}
 
+		if !event.Level.IsAtLeast(cfg.Bindings.MinLevel) {
+			b.log.Debugf("Skipping notification for channel %q as the event level %q is lower than %q.", cfg.Identifier(), event.Level, cfg.Bindings.MinLevel)
+			continue
+		}
+
 		out = append(out, cfg.Identifier())
 	}
 	return out
 }
 
+// renderEventMessage renders the event message for a given channel, using its custom template if configured.
+func (b *Slack) renderEventMessage(channelName string, event events.Event) slack.Attachment {
+	channelTemplate := b.getChannels()[channelName].Notification.Template
+	if msg, ok := renderCustomEventMessage(b.log, b.IntegrationName(), channelTemplate, event); ok {
+		return b.renderer.RenderCustomEventMessage(event, msg)
+	}
+
+	return b.renderer.RenderEventMessage(event)
+}
+
 // SendMessage sends message to slack channel
 func (b *Slack) SendMessage(ctx context.Context, msg interactive.Message) error {
 	errs := multierror.New()
@@ -344,11 +427,55 @@ func (b *Slack) SendMessage(ctx context.Context, msg interactive.Message) error
 	return errs.ErrorOrNil()
 }
 
+// SendScheduledReport sends a scheduled report to Slack channels bound to a given schedule.
+func (b *Slack) SendScheduledReport(_ context.Context, scheduleName string, render schedule.ReportRenderer) error {
+	errs := multierror.New()
+	for _, channel := range b.getChannels() {
+		if !sliceutil.Intersect([]string{scheduleName}, channel.Bindings.Schedules) {
+			continue
+		}
+
+		msg := render(schedule.Channel{Alias: channel.alias, ID: channel.Name, Bindings: channel.Bindings})
+		if err := b.send(slackMessage{Channel: channel.Name}, scheduleName, msg, false); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending scheduled report to channel %q (alias: %q): %w", channel.Name, channel.alias, err))
+			continue
+		}
+		b.log.Debugf("Scheduled report %q successfully sent to channel %q (alias: %q)", scheduleName, channel.Name, channel.alias)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// getDigestChannels returns digest configuration for channels with the digest notification mode.
+func (b *Slack) getDigestChannels() map[string]config.ChannelDigest {
+	out := map[string]config.ChannelDigest{}
+	for _, cfg := range b.getChannels() {
+		if cfg.Notification.IsDigest() {
+			out[cfg.Identifier()] = cfg.Notification.Digest
+		}
+	}
+	return out
+}
+
+// sendDigest sends the notification digest to a given Slack channel.
+func (b *Slack) sendDigest(_ context.Context, channelName string, msg interactive.Message) error {
+	return b.send(slackMessage{Channel: channelName}, "digest", msg, false)
+}
+
 // BotName returns the Bot name.
 func (b *Slack) BotName() string {
 	return fmt.Sprintf("<@%s>", b.botID)
 }
 
+// SetBindings sets bindings for already configured channels without restart.
+func (b *Slack) SetBindings(commGroup config.Communications) {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	b.setChannels(channelsByNameWithBindings(b.getChannels(), bindingsByAliasFromNames(commGroup.Slack.Channels)))
+}
+
 func (b *Slack) getChannels() map[string]channelConfigByName {
 	b.channelsMutex.RLock()
 	defer b.channelsMutex.RUnlock()
@@ -389,3 +516,44 @@ func uploadFileToSlack(channel string, resp interactive.Message, client *slack.C
 
 	return nil
 }
+
+// renderEventMessageWithLogs renders the event message with a given function.
+// If the rendered message exceeds the Slack message size limit, it is rendered again without the logs.
+// The removed logs are returned, so they can be uploaded as a file.
+func renderEventMessageWithLogs(event events.Event, render func(events.Event) slack.Attachment) (slack.Attachment, string) {
+	attachment := render(event)
+	if event.Logs == "" || slackAttachmentSize(attachment) < slackMaxMessageSize {
+		return attachment, ""
+	}
+
+	logs := event.Logs
+	event.Logs = ""
+	return render(event), logs
+}
+
+// slackAttachmentSize returns the size of the text rendered in a given attachment.
+func slackAttachmentSize(attachment slack.Attachment) int {
+	size := len(attachment.Fallback) + len(attachment.Pretext) + len(attachment.Title) + len(attachment.Text) + len(attachment.Footer)
+	for _, field := range attachment.Fields {
+		size += len(field.Title) + len(field.Value)
+	}
+	return size
+}
+
+// uploadEventLogsToSlack uploads the event logs as a file in the thread of the event message.
+func uploadEventLogsToSlack(ctx context.Context, client *slack.Client, channelID, threadTimestamp string, event events.Event, logs string) error {
+	params := slack.FileUploadParameters{
+		Filename:        fmt.Sprintf("%s.log", event.Name),
+		Title:           fmt.Sprintf("Logs of %s %s", event.Kind, event.Name),
+		Content:         logs,
+		Channels:        []string{channelID},
+		ThreadTimestamp: threadTimestamp,
+	}
+
+	_, err := client.UploadFileContext(ctx, params)
+	if err != nil {
+		return fmt.Errorf("while uploading logs file: %w", err)
+	}
+
+	return nil
+}
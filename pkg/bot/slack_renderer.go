@@ -42,6 +42,37 @@ func (b *SlackRenderer) RenderEventMessage(event events.Event) slack.Attachment
 		attachment = b.shortNotification(event)
 	}
 
+	return b.withEventMetadata(attachment, event)
+}
+
+// RenderCustomEventMessage returns Slack message with the event title and body rendered from a custom template.
+func (b *SlackRenderer) RenderCustomEventMessage(event events.Event, msg customEventMessage) slack.Attachment {
+	attachment := slack.Attachment{
+		Title: msg.Title,
+		Fields: []slack.AttachmentField{
+			{
+				Value: msg.Body,
+			},
+		},
+		Footer: "BotKube",
+	}
+
+	return b.withEventMetadata(attachment, event)
+}
+
+// WithActionButtons returns the event message with a given action buttons.
+func (b *SlackRenderer) WithActionButtons(attachment slack.Attachment, buttons interactive.Buttons) slack.Attachment {
+	if len(buttons) == 0 {
+		return attachment
+	}
+
+	attachment.Blocks = slack.Blocks{
+		BlockSet: b.renderButtons(buttons),
+	}
+	return attachment
+}
+
+func (b *SlackRenderer) withEventMetadata(attachment slack.Attachment, event events.Event) slack.Attachment {
 	// Add timestamp
 	ts := json.Number(strconv.FormatInt(event.TimeStamp.Unix(), 10))
 	if ts > "0" {
@@ -273,11 +304,15 @@ func (b *SlackRenderer) longNotification(event events.Event) slack.Attachment {
 	}
 
 	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, event.Namespace, "Namespace", true)
+	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, event.Workload(), "Workload", true)
+	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, event.Related(), "Related", true)
 	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, event.Reason, "Reason", true)
 	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, formatx.JoinMessages(event.Messages), "Message", false)
 	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, event.Action, "Action", true)
 	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, formatx.JoinMessages(event.Recommendations), "Recommendations", false)
 	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, formatx.JoinMessages(event.Warnings), "Warnings", false)
+	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, formatx.OptionalCodeBlock(event.Diff), "Diff", false)
+	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, formatx.OptionalCodeBlock(event.Logs), "Logs", false)
 	attachment.Fields = b.appendIfNotEmpty(attachment.Fields, event.Cluster, "Cluster", false)
 
 	return attachment
@@ -1,10 +1,15 @@
 package bot
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/slack-go/slack"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
 )
 
 func TestSlack_FindAndTrimBotMention(t *testing.T) {
@@ -50,3 +55,63 @@ func TestSlack_FindAndTrimBotMention(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderEventMessageWithLogs(t *testing.T) {
+	// given
+	longLogs := strings.Repeat("panic: missing config\n", slackMaxMessageSize/10)
+	renderWithLogs := func(event events.Event) slack.Attachment {
+		return slack.Attachment{
+			Title:  event.Name,
+			Fields: []slack.AttachmentField{{Title: "Logs", Value: event.Logs}},
+		}
+	}
+	renderWithoutLogs := func(event events.Event) slack.Attachment {
+		return slack.Attachment{Title: event.Name}
+	}
+
+	tests := []struct {
+		name         string
+		logs         string
+		render       func(events.Event) slack.Attachment
+		expEventLogs string
+		expSplitLogs string
+	}{
+		{
+			name:         "Short logs",
+			logs:         "panic: missing config",
+			render:       renderWithLogs,
+			expEventLogs: "panic: missing config",
+		},
+		{
+			name:         "Logs exceeding message size",
+			logs:         longLogs,
+			render:       renderWithLogs,
+			expSplitLogs: longLogs,
+		},
+		{
+			name:   "Logs not rendered in the message",
+			logs:   longLogs,
+			render: renderWithoutLogs,
+		},
+		{
+			name:   "No logs",
+			render: renderWithLogs,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			event := events.Event{Name: "api", Namespace: "team-a", Type: config.ErrorEvent, Logs: tc.logs}
+
+			// when
+			gotAttachment, gotLogs := renderEventMessageWithLogs(event, tc.render)
+
+			// then
+			var gotEventLogs string
+			if len(gotAttachment.Fields) > 0 {
+				gotEventLogs = gotAttachment.Fields[0].Value
+			}
+			assert.Equal(t, tc.expEventLogs, gotEventLogs)
+			assert.Equal(t, tc.expSplitLogs, gotLogs)
+		})
+	}
+}
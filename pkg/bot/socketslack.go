@@ -6,6 +6,8 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slack-go/slack"
@@ -18,6 +20,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/schedule"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 	"github.com/kubeshop/botkube/pkg/utils"
 )
@@ -31,18 +34,22 @@ var _ Bot = &SocketSlack{}
 
 // SocketSlack listens for user's message, execute commands and sends back the response.
 type SocketSlack struct {
-	log             logrus.FieldLogger
-	executorFactory ExecutorFactory
-	reporter        socketSlackAnalyticsReporter
-	botID           string
-	client          *slack.Client
-	channelsMutex   sync.RWMutex
-	channels        map[string]channelConfigByName
-	notifyMutex     sync.Mutex
-	botMentionRegex *regexp.Regexp
-	commGroupName   string
-	renderer        *SlackRenderer
-	mdFormatter     interactive.MDFormatter
+	log                 logrus.FieldLogger
+	executorFactory     ExecutorFactory
+	reporter            socketSlackAnalyticsReporter
+	botID               string
+	client              *slack.Client
+	channelsMutex       sync.RWMutex
+	channels            map[string]channelConfigByName
+	notifyMutex         sync.Mutex
+	botMentionRegex     *regexp.Regexp
+	commGroupName       string
+	clusterName         string
+	renderer            *SlackRenderer
+	mdFormatter         interactive.MDFormatter
+	digests             *eventDigests
+	quietHoursSummaries *eventDigests
+	eventActions        *EventActions
 }
 
 type socketSlackMessage struct {
@@ -52,8 +59,13 @@ type socketSlackMessage struct {
 	User                string
 	TriggerID           string
 	IsButtonClickOrigin bool
+	IsSlashCommand      bool
+	ForceEphemeral      bool
 }
 
+// slackEphemeralTruncatedNotice is appended to responses visible only to the user, which exceed the Slack message size limit.
+const slackEphemeralTruncatedNotice = "\n…\n_The response is too long to be displayed only to you, so it was truncated._"
+
 // socketSlackAnalyticsReporter defines a reporter that collects analytics data.
 type socketSlackAnalyticsReporter interface {
 	FatalErrorAnalyticsReporter
@@ -61,7 +73,7 @@ type socketSlackAnalyticsReporter interface {
 }
 
 // NewSocketSlack creates a new SocketSlack instance.
-func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.SocketSlack, executorFactory ExecutorFactory, reporter socketSlackAnalyticsReporter) (*SocketSlack, error) {
+func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.SocketSlack, clusterName string, executorFactory ExecutorFactory, eventActions *EventActions, reporter socketSlackAnalyticsReporter) (*SocketSlack, error) {
 	client := slack.New(cfg.BotToken, slack.OptionAppLevelToken(cfg.AppToken))
 	authResp, err := client.AuthTest()
 	if err != nil {
@@ -81,16 +93,20 @@ func NewSocketSlack(log logrus.FieldLogger, commGroupName string, cfg config.Soc
 
 	mdFormatter := interactive.NewMDFormatter(interactive.NewlineFormatter, mdHeaderFormatter)
 	return &SocketSlack{
-		log:             log,
-		executorFactory: executorFactory,
-		reporter:        reporter,
-		botID:           botID,
-		client:          client,
-		channels:        channels,
-		commGroupName:   commGroupName,
-		renderer:        NewSlackRenderer(cfg.Notification),
-		botMentionRegex: botMentionRegex,
-		mdFormatter:     mdFormatter,
+		log:                 log,
+		executorFactory:     executorFactory,
+		reporter:            reporter,
+		botID:               botID,
+		client:              client,
+		channels:            channels,
+		commGroupName:       commGroupName,
+		clusterName:         clusterName,
+		renderer:            NewSlackRenderer(cfg.Notification),
+		botMentionRegex:     botMentionRegex,
+		mdFormatter:         mdFormatter,
+		digests:             newEventDigests(log, notificationDigestHeader),
+		quietHoursSummaries: newEventDigests(log, quietHoursSummaryHeader),
+		eventActions:        eventActions,
 	}, nil
 }
 
@@ -111,6 +127,16 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.digests.Run(ctx, b.getDigestChannels(), b.sendDigest)
+	}()
+
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.quietHoursSummaries.RunWhenReady(ctx, quietHoursCheckInterval, b.quietHoursOver, b.sendDigest)
+	}()
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -147,8 +173,29 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 						if err := b.handleMessage(msg); err != nil {
 							b.log.Errorf("Message handling error: %s", err.Error())
 						}
+					case *slackevents.AppHomeOpenedEvent:
+						if ev.Tab != slackHomeTabName {
+							continue
+						}
+						b.log.Debugf("Got app home opened event %s", utils.StructDumper().Sdump(innerEvent))
+						if err := b.publishHomeTab(ctx, ev.User); err != nil {
+							b.log.Errorf("App Home publishing error: %s", err.Error())
+						}
 					}
 				}
+			case socketmode.EventTypeSlashCommand:
+				cmd, ok := event.Data.(slack.SlashCommand)
+				if !ok {
+					b.log.Errorf("Invalid event %+v\n", event.Data)
+					continue
+				}
+
+				websocketClient.Ack(*event.Request)
+				b.log.Debugf("Got slash command %s", utils.StructDumper().Sdump(cmd))
+
+				if err := b.handleSlashCommand(cmd); err != nil {
+					b.log.Errorf("Slash command handling error: %s", err.Error())
+				}
 			case socketmode.EventTypeInteractive:
 				callback, ok := event.Data.(slack.InteractionCallback)
 				if !ok {
@@ -176,6 +223,13 @@ func (b *SocketSlack) Start(ctx context.Context) error {
 						continue // skip the url actions
 					}
 
+					if callback.View.Type == slack.VTHomeTab {
+						if err := b.handleHomeTabAction(ctx, callback.User.ID, callback.TriggerID, *act); err != nil {
+							b.log.Errorf("App Home action handling error: %s", err.Error())
+						}
+						continue
+					}
+
 					channelID := callback.Channel.ID
 					if channelID == "" && callback.View.ID != "" {
 						// TODO: add support when we will need to handle button clicks from active modal.
@@ -269,24 +323,75 @@ func (b *SocketSlack) SetNotificationsEnabled(channelName string, enabled bool)
 	return nil
 }
 
+// QuietHours returns quiet hours configured for a given channel name.
+func (b *SocketSlack) QuietHours(channelName string) config.QuietHours {
+	channel, exists := b.getChannels()[channelName]
+	if !exists {
+		return config.QuietHours{}
+	}
+
+	return channel.Notification.QuietHours
+}
+
+// SetQuietHours sets quiet hours for a given channel name. Empty quiet hours disable them.
+func (b *SocketSlack) SetQuietHours(channelName string, quietHours config.QuietHours) error {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	channels := b.getChannels()
+	channel, exists := channels[channelName]
+	if !exists {
+		return execute.ErrNotificationsNotConfigured
+	}
+
+	channel.Notification.QuietHours = quietHours
+	channels[channelName] = channel
+	b.setChannels(channels)
+
+	return nil
+}
+
+// quietHoursOver returns true if quiet hours are not active for a given channel name.
+func (b *SocketSlack) quietHoursOver(channelName string) bool {
+	return !quietHoursActive(b.log, b.QuietHours(channelName), time.Now())
+}
+
+// handleSlashCommand executes a command sent via the slash command. The response is visible only for the sender.
+func (b *SocketSlack) handleSlashCommand(cmd slack.SlashCommand) error {
+	return b.handleMessage(socketSlackMessage{
+		Text:           cmd.Text,
+		Channel:        cmd.ChannelID,
+		User:           cmd.UserID,
+		TriggerID:      cmd.TriggerID,
+		IsSlashCommand: true,
+		ForceEphemeral: true,
+	})
+}
+
 func (b *SocketSlack) handleMessage(event socketSlackMessage) error {
-	// Handle message only if starts with mention
-	request, found := b.findAndTrimBotMention(event.Text)
-	if !found {
-		b.log.Debugf("Ignoring message as it doesn't contain %q mention", b.botID)
-		return nil
+	request := event.Text
+	if !event.IsSlashCommand {
+		// Handle message only if starts with mention
+		var found bool
+		request, found = b.findAndTrimBotMention(event.Text)
+		if !found {
+			b.log.Debugf("Ignoring message as it doesn't contain %q mention", b.botID)
+			return nil
+		}
 	}
 
-	// Unfortunately we need to do a call for channel name based on ID every time a message arrives.
-	// I wanted to query for channel IDs based on names and prepare a map in the `slackChannelsConfigFrom`,
-	// but unfortunately BotKube would need another scope (get all conversations).
-	// Keeping current way of doing this until we come up with a better idea.
-	info, err := b.client.GetConversationInfo(event.Channel, true)
+	channelName, err := b.resolveChannelName(event.Channel)
 	if err != nil {
-		return fmt.Errorf("while getting conversation info: %w", err)
+		return err
 	}
 
-	channel, isAuthChannel := b.getChannels()[info.Name]
+	channel, isAuthChannel := b.getChannels()[channelName]
+	conversationID := channel.Identifier()
+	if !isAuthChannel {
+		// keep the conversations which are not configured apart
+		conversationID = channelName
+	}
 
 	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
 		CommGroupName:   b.commGroupName,
@@ -294,7 +399,7 @@ func (b *SocketSlack) handleMessage(event socketSlackMessage) error {
 		NotifierHandler: b,
 		Conversation: execute.Conversation{
 			Alias:               channel.alias,
-			ID:                  channel.Identifier(),
+			ID:                  conversationID,
 			ExecutorBindings:    channel.Bindings.Executors,
 			IsAuthenticated:     isAuthChannel,
 			IsButtonClickOrigin: event.IsButtonClickOrigin,
@@ -303,6 +408,9 @@ func (b *SocketSlack) handleMessage(event socketSlackMessage) error {
 		User:    fmt.Sprintf("<@%s>", event.User),
 	})
 	response := e.Execute()
+	if event.ForceEphemeral {
+		response.OnlyVisibleForYou = true
+	}
 	err = b.send(event, request, response)
 	if err != nil {
 		return fmt.Errorf("while sending message: %w", err)
@@ -311,6 +419,25 @@ func (b *SocketSlack) handleMessage(event socketSlackMessage) error {
 	return nil
 }
 
+// resolveChannelName returns the channel name for a given channel ID.
+// Messages triggered from the App Home tab already contain a configured channel name, so the lookup is skipped.
+func (b *SocketSlack) resolveChannelName(channelIDOrName string) (string, error) {
+	if _, found := b.getChannels()[channelIDOrName]; found {
+		return channelIDOrName, nil
+	}
+
+	// Unfortunately we need to do a call for channel name based on ID every time a message arrives.
+	// I wanted to query for channel IDs based on names and prepare a map in the `slackChannelsConfigFrom`,
+	// but unfortunately BotKube would need another scope (get all conversations).
+	// Keeping current way of doing this until we come up with a better idea.
+	info, err := b.client.GetConversationInfo(channelIDOrName, true)
+	if err != nil {
+		return "", fmt.Errorf("while getting conversation info: %w", err)
+	}
+
+	return info.Name, nil
+}
+
 func (b *SocketSlack) send(event socketSlackMessage, req string, resp interactive.Message) error {
 	b.log.Debugf("Slack incoming Request: %s", req)
 	b.log.Debugf("Slack Response: %s", resp)
@@ -323,6 +450,10 @@ func (b *SocketSlack) send(event socketSlackMessage, req string, resp interactiv
 
 	// Upload message as a file if too long
 	if len(markdown) >= slackMaxMessageSize {
+		// uploaded files are visible to all channel members, so the response visible only to the user is truncated instead
+		if resp.OnlyVisibleForYou && event.User != "" {
+			return b.postTruncatedEphemeral(event, markdown)
+		}
 		return uploadFileToSlack(event.Channel, resp, b.client)
 	}
 
@@ -359,19 +490,60 @@ func (b *SocketSlack) send(event socketSlackMessage, req string, resp interactiv
 	return nil
 }
 
+func (b *SocketSlack) postTruncatedEphemeral(event socketSlackMessage, markdown string) error {
+	options := []slack.MsgOption{
+		slack.MsgOptionText(truncateSlackMessage(markdown, slackEphemeralTruncatedNotice), false),
+	}
+	if event.ThreadTimeStamp != "" {
+		options = append(options, slack.MsgOptionTS(event.ThreadTimeStamp))
+	}
+
+	if _, err := b.client.PostEphemeral(event.Channel, event.User, options...); err != nil {
+		return fmt.Errorf("while posting truncated Slack message visible only to user: %w", err)
+	}
+	return nil
+}
+
+// truncateSlackMessage truncates the message, so together with a given notice it fits in the Slack message size limit.
+func truncateSlackMessage(msg, notice string) string {
+	limit := slackMaxMessageSize - len(notice) - 1
+	if len(msg) <= limit {
+		return msg
+	}
+
+	// don't split multibyte characters
+	for limit > 0 && !utf8.RuneStart(msg[limit]) {
+		limit--
+	}
+	return msg[:limit] + notice
+}
+
 // SendEvent sends event notification to slack
 func (b *SocketSlack) SendEvent(ctx context.Context, event events.Event, eventSources []string) error {
 	b.log.Debugf("Sending to Slack: %+v", event)
-	attachment := b.renderer.RenderEventMessage(event)
 
 	errs := multierror.New()
 	for _, channelName := range b.getChannelsToNotify(event, eventSources) {
+		if channel, found := b.getChannels()[channelName]; found && deferEvent(b.log, b.digests, b.quietHoursSummaries, channelName, channel.Notification, event) {
+			continue
+		}
+
+		channelEvent := event.ForSources(b.getChannels()[channelName].Bindings.Sources)
+		attachment, logs := renderEventMessageWithLogs(channelEvent, func(event events.Event) slack.Attachment {
+			return b.renderEventMessage(channelName, event)
+		})
 		channelID, timestamp, err := b.client.PostMessageContext(ctx, channelName, slack.MsgOptionAttachments(attachment), slack.MsgOptionAsUser(true))
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while posting message to channel %q: %w", channelName, err))
 			continue
 		}
 
+		if logs != "" {
+			if err := uploadEventLogsToSlack(ctx, b.client, channelID, timestamp, event, logs); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("while uploading logs to channel %q: %w", channelName, err))
+			}
+		}
+
 		b.log.Debugf("Event successfully sent to channel %q (ID: %q) at %b", channelName, channelID, timestamp)
 	}
 
@@ -395,11 +567,32 @@ func (b *SocketSlack) getChannelsToNotify(event events.Event, eventSources []str
 			continue
 		}
 
+		if !event.Level.IsAtLeast(cfg.Bindings.MinLevel) {
+			b.log.Debugf("Skipping notification for channel %q as the event level %q is lower than %q.", cfg.Identifier(), event.Level, cfg.Bindings.MinLevel)
+			continue
+		}
+
 		out = append(out, cfg.Identifier())
 	}
 	return out
 }
 
+// renderEventMessage renders the event message for a given channel, using its custom template if configured.
+// The message contains action buttons for commands allowed in a given channel.
+func (b *SocketSlack) renderEventMessage(channelName string, event events.Event) slack.Attachment {
+	channel := b.getChannels()[channelName]
+
+	var attachment slack.Attachment
+	if msg, ok := renderCustomEventMessage(b.log, b.IntegrationName(), channel.Notification.Template, event); ok {
+		attachment = b.renderer.RenderCustomEventMessage(event, msg)
+	} else {
+		attachment = b.renderer.RenderEventMessage(event)
+	}
+
+	buttons := b.eventActions.ForEvent(event, channel.Bindings.Executors, b.BotName())
+	return b.renderer.WithActionButtons(attachment, buttons)
+}
+
 // SendMessage sends message with interactive sections to Slack channels.
 func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.Message) error {
 	errs := multierror.New()
@@ -420,11 +613,55 @@ func (b *SocketSlack) SendMessage(ctx context.Context, msg interactive.Message)
 	return errs.ErrorOrNil()
 }
 
+// SendScheduledReport sends a scheduled report to Slack channels bound to a given schedule.
+func (b *SocketSlack) SendScheduledReport(_ context.Context, scheduleName string, render schedule.ReportRenderer) error {
+	errs := multierror.New()
+	for _, channel := range b.getChannels() {
+		if !sliceutil.Intersect([]string{scheduleName}, channel.Bindings.Schedules) {
+			continue
+		}
+
+		msg := render(schedule.Channel{Alias: channel.alias, ID: channel.Name, Bindings: channel.Bindings})
+		if err := b.send(socketSlackMessage{Channel: channel.Name}, scheduleName, msg); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending scheduled report to channel %q (alias: %q): %w", channel.Name, channel.alias, err))
+			continue
+		}
+		b.log.Debugf("Scheduled report %q successfully sent to channel %q (alias: %q)", scheduleName, channel.Name, channel.alias)
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// getDigestChannels returns digest configuration for channels with the digest notification mode.
+func (b *SocketSlack) getDigestChannels() map[string]config.ChannelDigest {
+	out := map[string]config.ChannelDigest{}
+	for _, cfg := range b.getChannels() {
+		if cfg.Notification.IsDigest() {
+			out[cfg.Identifier()] = cfg.Notification.Digest
+		}
+	}
+	return out
+}
+
+// sendDigest sends the notification digest to a given Slack channel.
+func (b *SocketSlack) sendDigest(_ context.Context, channelName string, msg interactive.Message) error {
+	return b.send(socketSlackMessage{Channel: channelName}, "digest", msg)
+}
+
 // BotName returns the Bot name.
 func (b *SocketSlack) BotName() string {
 	return fmt.Sprintf("<@%s>", b.botID)
 }
 
+// SetBindings sets bindings for already configured channels without restart.
+func (b *SocketSlack) SetBindings(commGroup config.Communications) {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	b.setChannels(channelsByNameWithBindings(b.getChannels(), bindingsByAliasFromNames(commGroup.SocketSlack.Channels)))
+}
+
 func (b *SocketSlack) getChannels() map[string]channelConfigByName {
 	b.channelsMutex.RLock()
 	defer b.channelsMutex.RUnlock()
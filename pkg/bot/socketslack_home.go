@@ -0,0 +1,169 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+const (
+	// slackHomeTabName is the name of the App Home tab reported by the `app_home_opened` event.
+	slackHomeTabName = "home"
+
+	// homeTabChannelBlockIDPrefix is used to bind the App Home tab actions with a given channel name.
+	homeTabChannelBlockIDPrefix = "home:"
+
+	// homeTabConversationsPageLimit is the page size used when listing conversations of a given user.
+	homeTabConversationsPageLimit = 200
+)
+
+// publishHomeTab renders the App Home tab for a given user.
+// Only the channels which the user is a member of are listed.
+//
+// NOTE: The App Home tab is shared by all BotKube instances that use the same Slack app.
+// As a result, the view shows details of the cluster for which the tab was refreshed most recently.
+func (b *SocketSlack) publishHomeTab(ctx context.Context, userID string) error {
+	memberOf, err := b.userChannels(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.client.PublishViewContext(ctx, userID, b.renderHomeTab(memberOf), "")
+	if err != nil {
+		return fmt.Errorf("while publishing App Home view for user %q: %w", userID, err)
+	}
+	return nil
+}
+
+// handleHomeTabAction executes a command triggered by a button rendered in the App Home tab.
+// Buttons are bound to a given channel via the block ID, so the user membership is checked again before executing the command,
+// as the action payload can be crafted by the user.
+func (b *SocketSlack) handleHomeTabAction(ctx context.Context, userID, triggerID string, act slack.BlockAction) error {
+	channelName := strings.TrimPrefix(act.BlockID, homeTabChannelBlockIDPrefix)
+
+	memberOf, err := b.userChannels(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if _, isMember := memberOf[channelName]; !isMember {
+		return fmt.Errorf("user %q is not a member of channel %q", userID, channelName)
+	}
+
+	msg := socketSlackMessage{
+		Text:                resolveBlockActionCommand(act),
+		Channel:             channelName,
+		TriggerID:           triggerID,
+		User:                userID,
+		IsButtonClickOrigin: true,
+		ForceEphemeral:      true,
+	}
+	if err := b.handleMessage(msg); err != nil {
+		return fmt.Errorf("while handling message: %w", err)
+	}
+
+	return b.publishHomeTab(ctx, userID)
+}
+
+// userChannels returns names of the configured channels which a given user is a member of.
+func (b *SocketSlack) userChannels(ctx context.Context, userID string) (map[string]struct{}, error) {
+	configured := b.getChannels()
+
+	out := map[string]struct{}{}
+	params := &slack.GetConversationsForUserParameters{
+		UserID:          userID,
+		Types:           []string{"public_channel", "private_channel"},
+		Limit:           homeTabConversationsPageLimit,
+		ExcludeArchived: true,
+	}
+	for {
+		channels, nextCursor, err := b.client.GetConversationsForUserContext(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("while listing conversations for user %q: %w", userID, err)
+		}
+
+		for _, channel := range channels {
+			if _, found := configured[channel.Name]; found {
+				out[channel.Name] = struct{}{}
+			}
+		}
+
+		if nextCursor == "" {
+			return out, nil
+		}
+		params.Cursor = nextCursor
+	}
+}
+
+func (b *SocketSlack) renderHomeTab(memberOf map[string]struct{}) slack.HomeTabViewRequest {
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(b.renderer.plainTextBlock("BotKube")),
+		b.renderer.mdTextSection("*Clusters*\n• `%s`", b.clusterName),
+		slack.NewDividerBlock(),
+		b.renderer.mdTextSection("*Channels*"),
+	}
+
+	channels := b.getChannels()
+	names := make([]string, 0, len(memberOf))
+	for name := range channels {
+		if _, isMember := memberOf[name]; !isMember {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if len(names) == 0 {
+		blocks = append(blocks, b.renderer.mdTextSection("You are not a member of any channel configured for this cluster."))
+	}
+	for _, name := range names {
+		blocks = append(blocks, b.renderHomeTabChannel(channels[name])...)
+	}
+
+	return slack.HomeTabViewRequest{
+		Type: slack.VTHomeTab,
+		Blocks: slack.Blocks{
+			BlockSet: blocks,
+		},
+	}
+}
+
+func (b *SocketSlack) renderHomeTabChannel(channel channelConfigByName) []slack.Block {
+	notifierStatus, notifierBtn := "disabled", b.homeTabButton("Start notifications", "notifier start", interactive.ButtonStylePrimary)
+	if channel.notify {
+		notifierStatus, notifierBtn = "enabled", b.homeTabButton("Stop notifications", "notifier stop", interactive.ButtonStyleDanger)
+	}
+
+	sources := "none"
+	if len(channel.Bindings.Sources) > 0 {
+		sources = fmt.Sprintf("`%s`", strings.Join(channel.Bindings.Sources, "`, `"))
+	}
+
+	return []slack.Block{
+		slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*#%s*", channel.Identifier()), false, false),
+			[]*slack.TextBlockObject{
+				slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*Notifications:* %s", notifierStatus), false, false),
+				slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*Sources:* %s", sources), false, false),
+			},
+			nil,
+		),
+		slack.NewActionBlock(
+			homeTabChannelBlockIDPrefix+channel.Identifier(),
+			notifierBtn,
+			b.homeTabButton("Adjust notifications", "edit SourceBindings", interactive.ButtonStyleDefault),
+		),
+	}
+}
+
+func (b *SocketSlack) homeTabButton(name, cmd string, style interactive.ButtonStyle) slack.BlockElement {
+	return b.renderer.renderButton(interactive.Button{
+		Name:    name,
+		Command: fmt.Sprintf("%s %s", b.BotName(), cmd),
+		Style:   style,
+	})
+}
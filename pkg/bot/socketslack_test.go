@@ -0,0 +1,352 @@
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
+)
+
+func TestSocketSlack_RenderHomeTab(t *testing.T) {
+	// given
+	b := &SocketSlack{
+		botID:       "BotKube",
+		clusterName: "dev",
+		renderer:    NewSlackRenderer(config.Notification{}),
+		channels: map[string]channelConfigByName{
+			"alerts": {
+				ChannelBindingsByName: config.ChannelBindingsByName{
+					Name:     "alerts",
+					Bindings: config.BotBindings{Sources: []string{"k8s-err-events"}},
+				},
+				notify: true,
+			},
+			"audit": {
+				ChannelBindingsByName: config.ChannelBindingsByName{
+					Name: "audit",
+				},
+				notify: false,
+			},
+			"private": {
+				ChannelBindingsByName: config.ChannelBindingsByName{
+					Name: "private",
+				},
+				notify: true,
+			},
+		},
+	}
+	memberOf := map[string]struct{}{"alerts": {}, "audit": {}}
+
+	// when
+	view := b.renderHomeTab(memberOf)
+
+	// then
+	assert.Equal(t, slack.VTHomeTab, view.Type)
+
+	var actions []*slack.ActionBlock
+	for _, block := range view.Blocks.BlockSet {
+		if action, ok := block.(*slack.ActionBlock); ok {
+			actions = append(actions, action)
+		}
+	}
+	require.Len(t, actions, 2)
+
+	assert.Equal(t, "home:alerts", actions[0].BlockID)
+	stopBtn, ok := actions[0].Elements.ElementSet[0].(*slack.ButtonBlockElement)
+	require.True(t, ok)
+	assert.Equal(t, "<@BotKube> notifier stop", stopBtn.Value)
+
+	assert.Equal(t, "home:audit", actions[1].BlockID)
+	startBtn, ok := actions[1].Elements.ElementSet[0].(*slack.ButtonBlockElement)
+	require.True(t, ok)
+	assert.Equal(t, "<@BotKube> notifier start", startBtn.Value)
+}
+
+func TestSocketSlack_HandleSlashCommand(t *testing.T) {
+	// given
+	slackAPI := newFakeSlackAPI(t)
+	executors := &fakeExecutorFactory{}
+	b := fixSocketSlackWithAPI(t, slackAPI, executors)
+
+	// when
+	err := b.handleSlashCommand(slack.SlashCommand{
+		Text:      "get pods",
+		ChannelID: "C01",
+		UserID:    "U01",
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, executors.inputs, 1)
+	assert.Equal(t, "get pods", executors.inputs[0].Message)
+	assert.Equal(t, "<@U01>", executors.inputs[0].User)
+	assert.Equal(t, "alerts", executors.inputs[0].Conversation.ID)
+	assert.True(t, executors.inputs[0].Conversation.IsAuthenticated)
+
+	assert.Equal(t, []string{"C01/U01"}, slackAPI.ephemeralMessages())
+}
+
+func TestSocketSlack_HandleHomeTabAction(t *testing.T) {
+	tests := []struct {
+		name string
+
+		blockID string
+
+		expErrMsg         string
+		expExecutedIn     []string
+		expEphemeralMsgs  []string
+		expPublishedViews int
+	}{
+		{
+			name:              "Member of the channel",
+			blockID:           "home:alerts",
+			expExecutedIn:     []string{"alerts"},
+			expEphemeralMsgs:  []string{"alerts/U01"},
+			expPublishedViews: 1,
+		},
+		{
+			name:      "Not a member of the channel",
+			blockID:   "home:private",
+			expErrMsg: `user "U01" is not a member of channel "private"`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			slackAPI := newFakeSlackAPI(t)
+			executors := &fakeExecutorFactory{}
+			b := fixSocketSlackWithAPI(t, slackAPI, executors)
+
+			act := slack.BlockAction{
+				BlockID: tc.blockID,
+				Value:   "<@BotKube> notifier stop",
+			}
+
+			// when
+			err := b.handleHomeTabAction(context.Background(), "U01", "", act)
+
+			// then
+			if tc.expErrMsg != "" {
+				require.EqualError(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var executedIn []string
+			for _, in := range executors.inputs {
+				executedIn = append(executedIn, in.Conversation.ID)
+			}
+			assert.Equal(t, tc.expExecutedIn, executedIn)
+			assert.Equal(t, tc.expEphemeralMsgs, slackAPI.ephemeralMessages())
+			assert.Equal(t, tc.expPublishedViews, slackAPI.publishedViews())
+		})
+	}
+}
+
+func TestSocketSlack_SendTooLongResponse(t *testing.T) {
+	tests := []struct {
+		name string
+
+		onlyVisibleForYou bool
+
+		expEphemeralMsgs []string
+		expUploadedFiles int
+	}{
+		{
+			name:              "Response visible only to the user",
+			onlyVisibleForYou: true,
+			expEphemeralMsgs:  []string{"C01/U01"},
+		},
+		{
+			name:             "Response visible to all channel members",
+			expUploadedFiles: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			slackAPI := newFakeSlackAPI(t)
+			b := fixSocketSlackWithAPI(t, slackAPI, &fakeExecutorFactory{})
+
+			resp := interactive.Message{
+				Base: interactive.Base{
+					Body: interactive.Body{
+						CodeBlock: strings.Repeat("pod-name   1/1     Running   0          1d\n", slackMaxMessageSize/30),
+					},
+				},
+				OnlyVisibleForYou: tc.onlyVisibleForYou,
+			}
+
+			// when
+			err := b.send(socketSlackMessage{Channel: "C01", User: "U01"}, "get pods", resp)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expEphemeralMsgs, slackAPI.ephemeralMessages())
+			assert.Equal(t, tc.expUploadedFiles, slackAPI.uploadedFiles())
+			for _, text := range slackAPI.ephemeralMessageTexts() {
+				assert.Less(t, len(text), slackMaxMessageSize)
+				assert.True(t, strings.HasSuffix(text, slackEphemeralTruncatedNotice))
+			}
+		})
+	}
+}
+
+func TestTruncateSlackMessage(t *testing.T) {
+	// given
+	msg := strings.Repeat("ż", slackMaxMessageSize)
+
+	// when
+	out := truncateSlackMessage(msg, "(truncated)")
+
+	// then
+	assert.Less(t, len(out), slackMaxMessageSize)
+	assert.True(t, utf8.ValidString(out))
+	assert.True(t, strings.HasSuffix(out, "(truncated)"))
+	assert.Equal(t, "short", truncateSlackMessage("short", "(truncated)"))
+}
+
+func fixSocketSlackWithAPI(t *testing.T, slackAPI *fakeSlackAPI, executors ExecutorFactory) *SocketSlack {
+	t.Helper()
+
+	logger, _ := logtest.NewNullLogger()
+	botMentionRegex, err := slackBotMentionRegex("BotKube")
+	require.NoError(t, err)
+
+	return &SocketSlack{
+		log:             logger,
+		executorFactory: executors,
+		botID:           "BotKube",
+		client:          slack.New("token", slack.OptionAPIURL(slackAPI.server.URL+"/")),
+		botMentionRegex: botMentionRegex,
+		clusterName:     "dev",
+		renderer:        NewSlackRenderer(config.Notification{}),
+		mdFormatter:     interactive.NewMDFormatter(interactive.NewlineFormatter, mdHeaderFormatter),
+		channels: map[string]channelConfigByName{
+			"alerts": {
+				ChannelBindingsByName: config.ChannelBindingsByName{Name: "alerts"},
+				notify:                true,
+			},
+			"private": {
+				ChannelBindingsByName: config.ChannelBindingsByName{Name: "private"},
+				notify:                true,
+			},
+		},
+	}
+}
+
+// fakeSlackAPI serves the Slack Web API methods used by the SocketSlack bot.
+// The user is a member of the "alerts" channel only.
+type fakeSlackAPI struct {
+	server *httptest.Server
+
+	mu             sync.Mutex
+	ephemeral      []string
+	ephemeralTexts []string
+	views          int
+	uploads        int
+}
+
+func newFakeSlackAPI(t *testing.T) *fakeSlackAPI {
+	t.Helper()
+
+	api := &fakeSlackAPI{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/conversations.info", func(w http.ResponseWriter, _ *http.Request) {
+		writeSlackResponse(t, w, map[string]interface{}{"channel": map[string]string{"id": "C01", "name": "alerts"}})
+	})
+	mux.HandleFunc("/users.conversations", func(w http.ResponseWriter, _ *http.Request) {
+		writeSlackResponse(t, w, map[string]interface{}{"channels": []map[string]string{
+			{"id": "C01", "name": "alerts"},
+			{"id": "C02", "name": "random"},
+		}})
+	})
+	mux.HandleFunc("/chat.postEphemeral", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		api.mu.Lock()
+		api.ephemeral = append(api.ephemeral, r.Form.Get("channel")+"/"+r.Form.Get("user"))
+		api.ephemeralTexts = append(api.ephemeralTexts, r.Form.Get("text"))
+		api.mu.Unlock()
+		writeSlackResponse(t, w, map[string]interface{}{"message_ts": "1"})
+	})
+	mux.HandleFunc("/auth.test", func(w http.ResponseWriter, _ *http.Request) {
+		writeSlackResponse(t, w, map[string]interface{}{"user_id": "BotKube"})
+	})
+	mux.HandleFunc("/files.upload", func(w http.ResponseWriter, _ *http.Request) {
+		api.mu.Lock()
+		api.uploads++
+		api.mu.Unlock()
+		writeSlackResponse(t, w, map[string]interface{}{"file": map[string]string{"id": "F01"}})
+	})
+	mux.HandleFunc("/views.publish", func(w http.ResponseWriter, _ *http.Request) {
+		api.mu.Lock()
+		api.views++
+		api.mu.Unlock()
+		writeSlackResponse(t, w, map[string]interface{}{})
+	})
+	api.server = httptest.NewServer(mux)
+	t.Cleanup(api.server.Close)
+
+	return api
+}
+
+func (f *fakeSlackAPI) ephemeralMessages() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.ephemeral
+}
+
+func (f *fakeSlackAPI) ephemeralMessageTexts() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.ephemeralTexts
+}
+
+func (f *fakeSlackAPI) uploadedFiles() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.uploads
+}
+
+func (f *fakeSlackAPI) publishedViews() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.views
+}
+
+func writeSlackResponse(t *testing.T, w http.ResponseWriter, resp map[string]interface{}) {
+	t.Helper()
+
+	resp["ok"] = true
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(resp))
+}
+
+// fakeExecutorFactory records the executor inputs and responds with a static message.
+type fakeExecutorFactory struct {
+	inputs []execute.NewDefaultInput
+}
+
+func (f *fakeExecutorFactory) NewDefault(cfg execute.NewDefaultInput) execute.Executor {
+	f.inputs = append(f.inputs, cfg)
+	return &fakeExecutor{}
+}
+
+type fakeExecutor struct{}
+
+func (*fakeExecutor) Execute() interactive.Message {
+	return interactive.Message{Base: interactive.Base{Description: "done"}}
+}
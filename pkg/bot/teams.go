@@ -9,6 +9,7 @@ import (
 	"net/url"
 	"regexp"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/infracloudio/msbotbuilder-go/core"
@@ -16,12 +17,14 @@ import (
 	"github.com/infracloudio/msbotbuilder-go/schema"
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/internal/analytics"
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/execute"
 	"github.com/kubeshop/botkube/pkg/httpsrv"
 	"github.com/kubeshop/botkube/pkg/multierror"
+	"github.com/kubeshop/botkube/pkg/schedule"
 	"github.com/kubeshop/botkube/pkg/sliceutil"
 )
 
@@ -55,18 +58,29 @@ const teamsBotMentionPrefixFmt = "^<at>%s</at>"
 var mdEmojiTag = regexp.MustCompile(`:(\w+):`)
 
 type conversation struct {
-	ref    schema.ConversationReference
-	notify bool
+	ref        schema.ConversationReference
+	alias      string
+	bindings   config.BotBindings
+	notify     bool
+	quietHours config.QuietHours
+}
+
+// TeamsConversationsPersistenceManager manages persistence of MS Teams conversations.
+type TeamsConversationsPersistenceManager interface {
+	PersistTeamsConversation(ctx context.Context, commGroupName string, conversation config.TeamsConversationStartupState) error
+	ListTeamsConversations(ctx context.Context, commGroupName string) (map[string]config.TeamsConversationStartupState, error)
 }
 
 // Teams listens for user's message, execute commands and sends back the response.
 type Teams struct {
 	log             logrus.FieldLogger
 	executorFactory ExecutorFactory
-	reporter        AnalyticsReporter
-	// TODO: Be consistent with other communicators when Teams supports multiple channels
-	//channels map[string][ChannelBindingsByName]
+	cfgManager      TeamsConversationsPersistenceManager
+	reporter        FatalErrorAnalyticsReporter
+	// bindings are used for all conversations which are not configured under channels.
 	bindings           config.BotBindings
+	channels           map[string]channelConfigByName
+	channelsMutex      sync.RWMutex
 	conversationsMutex sync.RWMutex
 	commGroupName      string
 	conversations      map[string]conversation
@@ -74,6 +88,9 @@ type Teams struct {
 	botMentionRegex    *regexp.Regexp
 	longFormatter      interactive.MDFormatter
 	shortFormatter     interactive.MDFormatter
+	digests            *eventDigests
+	// quietHoursSummaries are identified by conversation channel IDs, as quiet hours are set per conversation.
+	quietHoursSummaries *eventDigests
 
 	botName      string
 	AppID        string
@@ -90,7 +107,7 @@ type consentContext struct {
 }
 
 // NewTeams creates a new Teams instance.
-func NewTeams(log logrus.FieldLogger, commGroupName string, cfg config.Teams, clusterName string, executorFactory ExecutorFactory, reporter AnalyticsReporter) (*Teams, error) {
+func NewTeams(log logrus.FieldLogger, commGroupName string, cfg config.Teams, clusterName string, executorFactory ExecutorFactory, cfgManager TeamsConversationsPersistenceManager, reporter FatalErrorAnalyticsReporter) (*Teams, error) {
 	botMentionRegex, err := teamsBotMentionRegex(cfg.BotName)
 	if err != nil {
 		return nil, err
@@ -109,22 +126,26 @@ func NewTeams(log logrus.FieldLogger, commGroupName string, cfg config.Teams, cl
 	shortFormatter := interactive.NewMDFormatter(shortLineFormatter, interactive.MdHeaderFormatter)
 
 	return &Teams{
-		log:             log,
-		executorFactory: executorFactory,
-		reporter:        reporter,
-		botName:         cfg.BotName,
-		ClusterName:     clusterName,
-		AppID:           cfg.AppID,
-		AppPassword:     cfg.AppPassword,
-		Notification:    cfg.Notification,
-		bindings:        cfg.Bindings,
-		commGroupName:   commGroupName,
-		MessagePath:     msgPath,
-		Port:            port,
-		conversations:   make(map[string]conversation),
-		botMentionRegex: botMentionRegex,
-		longFormatter:   longFormatter,
-		shortFormatter:  shortFormatter,
+		log:                 log,
+		executorFactory:     executorFactory,
+		cfgManager:          cfgManager,
+		reporter:            reporter,
+		botName:             cfg.BotName,
+		ClusterName:         clusterName,
+		AppID:               cfg.AppID,
+		AppPassword:         cfg.AppPassword,
+		Notification:        cfg.Notification,
+		bindings:            cfg.Bindings,
+		channels:            teamsChannelsConfigFrom(cfg.Channels),
+		commGroupName:       commGroupName,
+		MessagePath:         msgPath,
+		Port:                port,
+		conversations:       make(map[string]conversation),
+		botMentionRegex:     botMentionRegex,
+		longFormatter:       longFormatter,
+		shortFormatter:      shortFormatter,
+		digests:             newEventDigests(log, notificationDigestHeader),
+		quietHoursSummaries: newEventDigests(log, quietHoursSummaryHeader),
 	}, nil
 }
 
@@ -141,6 +162,22 @@ func (b *Teams) Start(ctx context.Context) error {
 		return fmt.Errorf("while starting Teams bot: %w", err)
 	}
 
+	err = b.loadConversations(ctx)
+	if err != nil {
+		// not critical, users can still register the conversations again with the `notifier start` command
+		b.log.Errorf("while loading persisted conversations: %s", err.Error())
+	}
+
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.digests.Run(ctx, b.getDigestChannels(), b.sendDigest)
+	}()
+
+	go func() {
+		defer analytics.ReportPanicIfOccurs(b.log, b.reporter)
+		b.quietHoursSummaries.RunWhenReady(ctx, quietHoursCheckInterval, b.quietHoursOver, b.sendQuietHoursSummary)
+	}()
+
 	addr := fmt.Sprintf(":%s", b.Port)
 
 	router := mux.NewRouter()
@@ -179,7 +216,7 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 
 	err = b.Adapter.ProcessActivity(ctx, activity, coreActivity.HandlerFuncs{
 		OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
-			n, resp := b.processMessage(turn.Activity)
+			n, resp := b.processMessage(ctx, turn.Activity)
 			if n >= teamsMaxMessageSize {
 				if turn.Activity.Conversation.ConversationType == convTypePersonal {
 					// send file upload request
@@ -241,7 +278,7 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 			}
 
 			activity.Text = consentCtx.Command
-			_, resp := b.processMessage(activity)
+			_, resp := b.processMessage(ctx, activity)
 
 			actJSON, err := json.MarshalIndent(turn.Activity, "", "  ")
 			if err != nil {
@@ -275,7 +312,7 @@ func (b *Teams) processActivity(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func (b *Teams) processMessage(activity schema.Activity) (int, string) {
+func (b *Teams) processMessage(ctx context.Context, activity schema.Activity) (int, string) {
 	trimmedMsg := b.trimBotMention(activity.Text)
 
 	// Multicluster is not supported for Teams
@@ -286,17 +323,24 @@ func (b *Teams) processMessage(activity schema.Activity) (int, string) {
 		return 0, ""
 	}
 
+	conv := b.getConversationFor(ref, teamsChannelNameFrom(activity))
+	if conv.alias != "" {
+		// configured channels don't need to wait for the `notifier start` command to receive notifications
+		b.addConversationIfMissing(ctx, conv)
+	}
+
 	e := b.executorFactory.NewDefault(execute.NewDefaultInput{
 		CommGroupName:   b.commGroupName,
 		Platform:        b.IntegrationName(),
-		NotifierHandler: newTeamsNotifMgrForActivity(b, ref),
+		NotifierHandler: newTeamsNotifMgrForActivity(ctx, b, conv),
 		Conversation: execute.Conversation{
-			Alias:            "",
+			Alias:            conv.alias,
 			IsAuthenticated:  true,
 			ID:               ref.ChannelID,
-			ExecutorBindings: b.bindings.Executors,
+			ExecutorBindings: conv.bindings.Executors,
 		},
 		Message: trimmedMsg,
+		User:    activity.From.ID,
 	})
 	return b.convertInteractiveMessage(e.Execute(), false)
 }
@@ -354,19 +398,26 @@ func (b *Teams) putRequest(u string, data []byte) (err error) {
 // SendEvent sends event message via Bot interface
 func (b *Teams) SendEvent(ctx context.Context, event events.Event, eventSources []string) error {
 	b.log.Debugf("Sending to Teams: %+v", event)
-	card := b.formatMessage(event, b.Notification)
+	errs := multierror.New()
+	digestAliases := map[string]struct{}{}
+	for _, conv := range b.getConversationsToNotify(event, eventSources) {
+		convRef := conv.ref
+		channel, _ := b.findChannelConfigByAlias(conv.alias)
+		if channel.Notification.IsDigest() {
+			// many conversations can be registered for a single channel alias, so the event is recorded only once
+			if _, recorded := digestAliases[conv.alias]; !recorded {
+				b.digests.Add(conv.alias, event)
+				digestAliases[conv.alias] = struct{}{}
+			}
+			continue
+		}
 
-	if !sliceutil.Intersect(eventSources, b.bindings.Sources) {
-		b.log.Debugf(
-			"Event was not sent as bot source bindings: %+v do not overlap with the event's sources: %+v",
-			b.bindings.Sources,
-			eventSources,
-		)
-		return nil
-	}
+		if holdBackDuringQuietHours(b.log, conv.quietHours, event, time.Now()) {
+			b.quietHoursSummaries.Add(convRef.ChannelID, event)
+			continue
+		}
 
-	errs := multierror.New()
-	for _, convRef := range b.getConversationRefsToNotify() {
+		card := b.formatMessage(event.ForSources(conv.bindings.Sources), b.Notification, channel.Notification.Template)
 		err := b.sendProactiveMessage(ctx, convRef, card)
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while posting message to channel %q: %w", convRef.ChannelID, err))
@@ -402,6 +453,32 @@ func (b *Teams) SendMessage(ctx context.Context, msg interactive.Message) error
 	return errs.ErrorOrNil()
 }
 
+// SendScheduledReport sends a scheduled report to MS Teams conversations bound to a given schedule.
+func (b *Teams) SendScheduledReport(ctx context.Context, scheduleName string, render schedule.ReportRenderer) error {
+	errs := multierror.New()
+	for _, convCfg := range b.getConversations() {
+		if !sliceutil.Intersect([]string{scheduleName}, convCfg.bindings.Schedules) {
+			continue
+		}
+
+		channelID := convCfg.ref.ChannelID
+		msg := render(schedule.Channel{Alias: convCfg.alias, ID: channelID, Bindings: convCfg.bindings})
+		_, converted := b.convertInteractiveMessage(msg, true)
+		err := b.Adapter.ProactiveMessage(ctx, convCfg.ref, coreActivity.HandlerFuncs{
+			OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
+				return turn.SendActivity(coreActivity.MsgOptionText(converted))
+			},
+		})
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending scheduled report to channel %q: %w", channelID, err))
+			continue
+		}
+		b.log.Debugf("Scheduled report %q successfully sent to channel %q", scheduleName, channelID)
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // IntegrationName describes the integration name.
 func (b *Teams) IntegrationName() config.CommPlatformIntegration {
 	return config.TeamsCommPlatformIntegration
@@ -422,30 +499,242 @@ func (b *Teams) NotificationsEnabled(channelID string) bool {
 	return channel.notify
 }
 
-// SetNotificationsEnabled sets a new notification status for a given channel ID.
-func (b *Teams) SetNotificationsEnabled(enabled bool, ref schema.ConversationReference) error {
+// setNotificationsEnabled sets a new notification status for a given conversation.
+func (b *Teams) setNotificationsEnabled(ctx context.Context, enabled bool, in conversation) error {
 	// avoid race conditions with using the setter concurrently, as we set whole map
 	b.notifyMutex.Lock()
 	defer b.notifyMutex.Unlock()
 
 	conversations := b.getConversations()
-	conv, exists := conversations[ref.ChannelID]
+	conv, exists := conversations[in.ref.ChannelID]
 	if !exists {
 		// not returning execute.ErrNotificationsNotConfigured error, as MS Teams channels are configured dynamically.
 		// In such case this shouldn't be considered as an error.
+		conv = in
+	}
 
-		conv = conversation{
-			ref: ref,
+	conv.notify = enabled
+	conversations[in.ref.ChannelID] = conv
+	b.setConversations(conversations)
+
+	if conv.alias != "" {
+		// configured channels persist the notifications state in their own configuration
+		b.updateChannelConfig(conv.alias, func(channel *channelConfigByName) {
+			channel.notify = enabled
+		})
+		if exists {
+			return nil
 		}
 	}
 
-	conv.notify = enabled
-	conversations[ref.ChannelID] = conv
+	err := b.persistConversation(ctx, conv)
+	if err != nil {
+		return fmt.Errorf("while persisting conversation: %w", err)
+	}
+
+	return nil
+}
+
+// QuietHours returns quiet hours configured for a given channel ID.
+func (b *Teams) QuietHours(channelID string) config.QuietHours {
+	channel, exists := b.getConversations()[channelID]
+	if !exists {
+		return config.QuietHours{}
+	}
+
+	return channel.quietHours
+}
+
+// setQuietHours sets quiet hours for a given conversation. Empty quiet hours disable them.
+func (b *Teams) setQuietHours(ctx context.Context, quietHours config.QuietHours, in conversation) error {
+	// avoid race conditions with using the setter concurrently, as we set whole map
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	conversations := b.getConversations()
+	conv, exists := conversations[in.ref.ChannelID]
+	if !exists {
+		conv = in
+	}
+
+	conv.quietHours = quietHours
+	conversations[in.ref.ChannelID] = conv
+	b.setConversations(conversations)
+
+	if conv.alias != "" {
+		// configured channels persist quiet hours in their own configuration
+		b.updateChannelConfig(conv.alias, func(channel *channelConfigByName) {
+			channel.Notification.QuietHours = quietHours
+		})
+		if exists {
+			return nil
+		}
+	}
+
+	err := b.persistConversation(ctx, conv)
+	if err != nil {
+		return fmt.Errorf("while persisting conversation: %w", err)
+	}
+
+	return nil
+}
+
+// quietHoursOver returns true if quiet hours are not active for a given channel ID.
+func (b *Teams) quietHoursOver(channelID string) bool {
+	return !quietHoursActive(b.log, b.QuietHours(channelID), time.Now())
+}
+
+// addConversationIfMissing registers a given conversation if it's not already known.
+func (b *Teams) addConversationIfMissing(ctx context.Context, in conversation) {
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	conversations := b.getConversations()
+	if _, exists := conversations[in.ref.ChannelID]; exists {
+		return
+	}
+
+	conversations[in.ref.ChannelID] = in
 	b.setConversations(conversations)
 
+	err := b.persistConversation(ctx, in)
+	if err != nil {
+		b.log.Errorf("while persisting conversation for channel %q: %s", in.ref.ChannelID, err.Error())
+	}
+}
+
+// loadConversations restores conversations persisted before BotKube restart.
+func (b *Teams) loadConversations(ctx context.Context) error {
+	persisted, err := b.cfgManager.ListTeamsConversations(ctx, b.commGroupName)
+	if err != nil {
+		return err
+	}
+
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	conversations := b.getConversations()
+	for channelID, state := range persisted {
+		conversations[channelID] = b.conversationFromState(state)
+	}
+	b.setConversations(conversations)
+
+	b.log.Infof("Loaded %d persisted conversation(s)", len(persisted))
 	return nil
 }
 
+// persistConversation persists the conversation reference. The notifications state is persisted only for conversations
+// which are not configured under channels, as configured channels are the single source of truth for it.
+func (b *Teams) persistConversation(ctx context.Context, conv conversation) error {
+	state := config.TeamsConversationStartupState{
+		Alias: conv.alias,
+		Reference: config.TeamsConversationReference{
+			ActivityID:       conv.ref.ActivityID,
+			ChannelID:        conv.ref.ChannelID,
+			ServiceURL:       conv.ref.ServiceURL,
+			ConversationID:   conv.ref.Conversation.ID,
+			ConversationType: conv.ref.Conversation.ConversationType,
+			IsGroup:          conv.ref.Conversation.IsGroup,
+			TenantID:         conv.ref.Conversation.TenantID,
+			BotID:            conv.ref.Bot.ID,
+			BotName:          conv.ref.Bot.Name,
+			UserID:           conv.ref.User.ID,
+			UserName:         conv.ref.User.Name,
+		},
+	}
+	if conv.alias == "" {
+		state.Notify = conv.notify
+		state.QuietHours = conv.quietHours
+	}
+
+	return b.cfgManager.PersistTeamsConversation(ctx, b.commGroupName, state)
+}
+
+// conversationFromState converts the persisted conversation. If the channel is not configured anymore, the default bindings are used.
+func (b *Teams) conversationFromState(in config.TeamsConversationStartupState) conversation {
+	conv := conversation{
+		ref: schema.ConversationReference{
+			ActivityID: in.Reference.ActivityID,
+			ChannelID:  in.Reference.ChannelID,
+			ServiceURL: in.Reference.ServiceURL,
+			Conversation: schema.ConversationAccount{
+				ID:               in.Reference.ConversationID,
+				ConversationType: in.Reference.ConversationType,
+				IsGroup:          in.Reference.IsGroup,
+				TenantID:         in.Reference.TenantID,
+			},
+			Bot: schema.ChannelAccount{
+				ID:   in.Reference.BotID,
+				Name: in.Reference.BotName,
+			},
+			User: schema.ChannelAccount{
+				ID:   in.Reference.UserID,
+				Name: in.Reference.UserName,
+			},
+		},
+		bindings:   b.getDefaultBindings(),
+		notify:     in.Notify,
+		quietHours: in.QuietHours,
+	}
+
+	if in.Alias == "" {
+		return conv
+	}
+
+	for _, channel := range b.getChannels() {
+		if channel.alias != in.Alias {
+			continue
+		}
+
+		conv.alias = channel.alias
+		conv.bindings = channel.Bindings
+		conv.notify = channel.notify
+		conv.quietHours = channel.Notification.QuietHours
+		break
+	}
+
+	return conv
+}
+
+// getConversationFor returns conversation details for a given reference.
+// Conversations configured under channels use their own bindings, all others use the default ones.
+func (b *Teams) getConversationFor(ref schema.ConversationReference, channelName string) conversation {
+	if conv, exists := b.getConversations()[ref.ChannelID]; exists {
+		conv.ref = ref
+		return conv
+	}
+
+	channel, found := b.findChannelConfig(ref.ChannelID, channelName)
+	if !found {
+		return conversation{
+			ref:      ref,
+			bindings: b.getDefaultBindings(),
+		}
+	}
+
+	return conversation{
+		ref:        ref,
+		alias:      channel.alias,
+		bindings:   channel.Bindings,
+		notify:     channel.notify,
+		quietHours: channel.Notification.QuietHours,
+	}
+}
+
+// findChannelConfig finds the channel configuration by Teams channel ID or name.
+func (b *Teams) findChannelConfig(channelID, channelName string) (channelConfigByName, bool) {
+	if channel, found := b.getChannels()[channelID]; found {
+		return channel, true
+	}
+
+	if channelName == "" {
+		return channelConfigByName{}, false
+	}
+
+	channel, found := b.getChannels()[channelName]
+	return channel, found
+}
+
 // BotName returns the Bot name.
 func (b *Teams) BotName() string {
 	return fmt.Sprintf("@%s", b.botName)
@@ -466,18 +755,160 @@ func (b *Teams) sendProactiveMessage(ctx context.Context, convRef schema.Convers
 	return err
 }
 
-func (b *Teams) getConversationRefsToNotify() []schema.ConversationReference {
-	// TODO(https://github.com/kubeshop/botkube/issues/596): Support source bindings - filter events here or at source level and pass it every time via event property?
-	var convRefsToNotify []schema.ConversationReference
+func (b *Teams) getConversationsToNotify(event events.Event, eventSources []string) []conversation {
+	var out []conversation
 	for _, convConfig := range b.getConversations() {
 		if !convConfig.notify {
 			b.log.Infof("Skipping notification for channel %q as notifications are disabled.", convConfig.ref.ChannelID)
 			continue
 		}
 
-		convRefsToNotify = append(convRefsToNotify, convConfig.ref)
+		if !sliceutil.Intersect(eventSources, convConfig.bindings.Sources) {
+			b.log.Debugf(
+				"Skipping notification for channel %q as its source bindings: %+v do not overlap with the event's sources: %+v",
+				convConfig.ref.ChannelID,
+				convConfig.bindings.Sources,
+				eventSources,
+			)
+			continue
+		}
+
+		if !event.Level.IsAtLeast(convConfig.bindings.MinLevel) {
+			b.log.Debugf("Skipping notification for channel %q as the event level %q is lower than %q.", convConfig.ref.ChannelID, event.Level, convConfig.bindings.MinLevel)
+			continue
+		}
+
+		out = append(out, convConfig)
+	}
+	return out
+}
+
+// getDigestChannels returns digest configuration for channels with the digest notification mode.
+// As MS Teams conversations are registered dynamically, the digests are identified by channel aliases.
+func (b *Teams) getDigestChannels() map[string]config.ChannelDigest {
+	out := map[string]config.ChannelDigest{}
+	for _, cfg := range b.getChannels() {
+		if cfg.Notification.IsDigest() {
+			out[cfg.alias] = cfg.Notification.Digest
+		}
 	}
-	return convRefsToNotify
+	return out
+}
+
+func (b *Teams) findChannelConfigByAlias(alias string) (channelConfigByName, bool) {
+	if alias == "" {
+		return channelConfigByName{}, false
+	}
+	for _, cfg := range b.getChannels() {
+		if cfg.alias == alias {
+			return cfg, true
+		}
+	}
+	return channelConfigByName{}, false
+}
+
+// sendDigest sends the notification digest to MS Teams conversations for a given channel alias.
+func (b *Teams) sendDigest(ctx context.Context, alias string, msg interactive.Message) error {
+	_, converted := b.convertInteractiveMessage(msg, true)
+
+	errs := multierror.New()
+	for _, convCfg := range b.getConversations() {
+		if convCfg.alias != alias {
+			continue
+		}
+
+		err := b.Adapter.ProactiveMessage(ctx, convCfg.ref, coreActivity.HandlerFuncs{
+			OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
+				return turn.SendActivity(coreActivity.MsgOptionText(converted))
+			},
+		})
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while sending Teams message to channel %q: %w", convCfg.ref.ChannelID, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// sendQuietHoursSummary sends the quiet hours summary to a MS Teams conversation with a given channel ID.
+func (b *Teams) sendQuietHoursSummary(ctx context.Context, channelID string, msg interactive.Message) error {
+	convCfg, exists := b.getConversations()[channelID]
+	if !exists {
+		return fmt.Errorf("conversation for channel %q not found", channelID)
+	}
+
+	_, converted := b.convertInteractiveMessage(msg, true)
+	err := b.Adapter.ProactiveMessage(ctx, convCfg.ref, coreActivity.HandlerFuncs{
+		OnMessageFunc: func(turn *coreActivity.TurnContext) (schema.Activity, error) {
+			return turn.SendActivity(coreActivity.MsgOptionText(converted))
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("while sending Teams message to channel %q: %w", channelID, err)
+	}
+
+	return nil
+}
+
+// SetBindings sets bindings for already configured channels and the default bindings without restart.
+// Bindings of already registered conversations are updated as well.
+func (b *Teams) SetBindings(commGroup config.Communications) {
+	// avoid race conditions with using the setter concurrently, as we set whole maps
+	b.notifyMutex.Lock()
+	defer b.notifyMutex.Unlock()
+
+	channelBindings := bindingsByAliasFromNames(commGroup.Teams.Channels)
+	b.setChannels(channelsByNameWithBindings(b.getChannels(), channelBindings))
+	b.setDefaultBindings(commGroup.Teams.Bindings)
+
+	conversations := make(map[string]conversation)
+	for key, conv := range b.getConversations() {
+		conv.bindings = commGroup.Teams.Bindings
+		if bindings, exists := channelBindings[conv.alias]; exists && conv.alias != "" {
+			conv.bindings = bindings
+		}
+		conversations[key] = conv
+	}
+	b.setConversations(conversations)
+}
+
+func (b *Teams) getChannels() map[string]channelConfigByName {
+	b.channelsMutex.RLock()
+	defer b.channelsMutex.RUnlock()
+	return b.channels
+}
+
+func (b *Teams) setChannels(channels map[string]channelConfigByName) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+	b.channels = channels
+}
+
+// updateChannelConfig updates the configuration of a channel with a given alias.
+func (b *Teams) updateChannelConfig(alias string, updateFn func(channel *channelConfigByName)) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+
+	channels := make(map[string]channelConfigByName, len(b.channels))
+	for key, channel := range b.channels {
+		if channel.alias == alias {
+			updateFn(&channel)
+		}
+		channels[key] = channel
+	}
+	b.channels = channels
+}
+
+func (b *Teams) getDefaultBindings() config.BotBindings {
+	b.channelsMutex.RLock()
+	defer b.channelsMutex.RUnlock()
+	return b.bindings
+}
+
+func (b *Teams) setDefaultBindings(bindings config.BotBindings) {
+	b.channelsMutex.Lock()
+	defer b.channelsMutex.Unlock()
+	b.bindings = bindings
 }
 
 func (b *Teams) getConversations() map[string]conversation {
@@ -516,17 +947,29 @@ func (b *Teams) getConversationReferenceFrom(activity schema.Activity) (schema.C
 	return ref, nil
 }
 
+// teamsChannelNameFrom returns the Teams channel name from a given activity, if available.
+func teamsChannelNameFrom(activity schema.Activity) string {
+	rawChannel, ok := activity.ChannelData["channel"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	name, _ := rawChannel["name"].(string)
+	return name
+}
+
 func (b *Teams) trimBotMention(msg string) string {
 	return b.botMentionRegex.ReplaceAllString(msg, "")
 }
 
 type teamsNotificationManager struct {
-	b   *Teams
-	ref schema.ConversationReference
+	ctx  context.Context
+	b    *Teams
+	conv conversation
 }
 
-func newTeamsNotifMgrForActivity(b *Teams, ref schema.ConversationReference) *teamsNotificationManager {
-	return &teamsNotificationManager{b: b, ref: ref}
+func newTeamsNotifMgrForActivity(ctx context.Context, b *Teams, conv conversation) *teamsNotificationManager {
+	return &teamsNotificationManager{ctx: ctx, b: b, conv: conv}
 }
 
 // NotificationsEnabled returns current notification status for a given channel ID.
@@ -536,7 +979,17 @@ func (n *teamsNotificationManager) NotificationsEnabled(channelID string) bool {
 
 // SetNotificationsEnabled sets a new notification status for a given channel ID.
 func (n *teamsNotificationManager) SetNotificationsEnabled(_ string, enabled bool) error {
-	return n.b.SetNotificationsEnabled(enabled, n.ref)
+	return n.b.setNotificationsEnabled(n.ctx, enabled, n.conv)
+}
+
+// QuietHours returns quiet hours configured for a given channel ID.
+func (n *teamsNotificationManager) QuietHours(channelID string) config.QuietHours {
+	return n.b.QuietHours(channelID)
+}
+
+// SetQuietHours sets quiet hours for a given channel ID.
+func (n *teamsNotificationManager) SetQuietHours(_ string, quietHours config.QuietHours) error {
+	return n.b.setQuietHours(n.ctx, quietHours, n.conv)
 }
 
 // BotName returns the Bot name.
@@ -544,6 +997,19 @@ func (n *teamsNotificationManager) BotName() string {
 	return n.b.BotName()
 }
 
+func teamsChannelsConfigFrom(channelsCfg config.IdentifiableMap[config.ChannelBindingsByName]) map[string]channelConfigByName {
+	res := make(map[string]channelConfigByName)
+	for channAlias, channCfg := range channelsCfg {
+		res[channCfg.Identifier()] = channelConfigByName{
+			ChannelBindingsByName: channCfg,
+			alias:                 channAlias,
+			notify:                !channCfg.Notification.Disabled,
+		}
+	}
+
+	return res
+}
+
 func teamsBotMentionRegex(botName string) (*regexp.Regexp, error) {
 	botMentionRegex, err := regexp.Compile(fmt.Sprintf(teamsBotMentionPrefixFmt, botName))
 	if err != nil {
@@ -20,7 +20,11 @@ var themeColor = map[config.Level]string{
 // TODO: Use dedicated types as a part of https://github.com/kubeshop/botkube/issues/667
 type fact map[string]interface{}
 
-func (b *Teams) formatMessage(event events.Event, notification config.Notification) map[string]interface{} {
+func (b *Teams) formatMessage(event events.Event, notification config.Notification, channelTemplate config.EventTemplate) map[string]interface{} {
+	if custom, ok := renderCustomEventMessage(b.log, b.IntegrationName(), channelTemplate, event); ok {
+		return b.textCard(event, custom.Title, custom.Body)
+	}
+
 	switch notification.Type {
 	case config.LongNotification:
 		return b.longNotification(event)
@@ -34,6 +38,10 @@ func (b *Teams) formatMessage(event events.Event, notification config.Notificati
 }
 
 func (b *Teams) shortNotification(event events.Event) map[string]interface{} {
+	return b.textCard(event, event.Title, format.ShortMessage(event))
+}
+
+func (b *Teams) textCard(event events.Event, title, text string) map[string]interface{} {
 	return map[string]interface{}{
 		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
 		"type":    "AdaptiveCard",
@@ -41,14 +49,14 @@ func (b *Teams) shortNotification(event events.Event) map[string]interface{} {
 		"body": []map[string]interface{}{
 			{
 				"type":  "TextBlock",
-				"text":  event.Title,
+				"text":  title,
 				"size":  "Large",
 				"color": themeColor[event.Level],
 				"wrap":  true,
 			},
 			{
 				"type": "TextBlock",
-				"text": strings.ReplaceAll(format.ShortMessage(event), "```", ""),
+				"text": strings.ReplaceAll(text, "```", ""),
 				"wrap": true,
 			},
 		},
@@ -75,11 +83,15 @@ func (b *Teams) longNotification(event events.Event) map[string]interface{} {
 	}
 
 	sectionFacts = b.appendIfNotEmpty(sectionFacts, event.Namespace, "Namespace")
+	sectionFacts = b.appendIfNotEmpty(sectionFacts, event.Workload(), "Workload")
+	sectionFacts = b.appendIfNotEmpty(sectionFacts, event.Related(), "Related")
 	sectionFacts = b.appendIfNotEmpty(sectionFacts, event.Reason, "Reason")
 	sectionFacts = b.appendIfNotEmpty(sectionFacts, formatx.JoinMessages(event.Messages), "Message")
 	sectionFacts = b.appendIfNotEmpty(sectionFacts, event.Action, "Action")
 	sectionFacts = b.appendIfNotEmpty(sectionFacts, formatx.JoinMessages(event.Recommendations), "Recommendations")
 	sectionFacts = b.appendIfNotEmpty(sectionFacts, formatx.JoinMessages(event.Warnings), "Warnings")
+	sectionFacts = b.appendIfNotEmpty(sectionFacts, event.Diff, "Diff")
+	sectionFacts = b.appendIfNotEmpty(sectionFacts, event.Logs, "Logs")
 	sectionFacts = b.appendIfNotEmpty(sectionFacts, event.Cluster, "Cluster")
 
 	card["body"] = []map[string]interface{}{
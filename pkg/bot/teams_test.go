@@ -1,10 +1,17 @@
 package bot
 
 import (
+	"context"
 	"testing"
 
+	"github.com/infracloudio/msbotbuilder-go/schema"
+	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute"
 )
 
 func TestTeams_TrimBotMention(t *testing.T) {
@@ -47,3 +54,191 @@ func TestTeams_TrimBotMention(t *testing.T) {
 		})
 	}
 }
+
+func TestTeams_GetConversationFor(t *testing.T) {
+	// given
+	defaultBindings := config.BotBindings{Sources: []string{"k8s-events"}, Executors: []string{"kubectl-read-only"}}
+	alertsBindings := config.BotBindings{Sources: []string{"k8s-err-events"}, Executors: []string{"kubectl-all"}}
+	auditBindings := config.BotBindings{Sources: []string{"k8s-audit-events"}}
+	registeredBindings := config.BotBindings{Sources: []string{"k8s-registered-events"}}
+
+	b := &Teams{
+		bindings: defaultBindings,
+		channels: teamsChannelsConfigFrom(config.IdentifiableMap[config.ChannelBindingsByName]{
+			"alerts": {Name: "19:alerts@thread.tacv2", Bindings: alertsBindings},
+			"audit":  {Name: "Audit", Bindings: auditBindings, Notification: config.ChannelNotification{Disabled: true}},
+		}),
+		conversations: map[string]conversation{
+			"19:registered@thread.tacv2": {alias: "registered", bindings: registeredBindings, notify: true},
+		},
+	}
+
+	tests := []struct {
+		name string
+
+		channelID   string
+		channelName string
+
+		expAlias    string
+		expBindings config.BotBindings
+		expNotify   bool
+	}{
+		{
+			name:        "Channel configured by ID",
+			channelID:   "19:alerts@thread.tacv2",
+			channelName: "Alerts",
+			expAlias:    "alerts",
+			expBindings: alertsBindings,
+			expNotify:   true,
+		},
+		{
+			name:        "Channel configured by name",
+			channelID:   "19:audit@thread.tacv2",
+			channelName: "Audit",
+			expAlias:    "audit",
+			expBindings: auditBindings,
+			expNotify:   false,
+		},
+		{
+			name:        "Channel not configured",
+			channelID:   "19:other@thread.tacv2",
+			channelName: "Other",
+			expBindings: defaultBindings,
+		},
+		{
+			name:        "Personal chat without channel name",
+			channelID:   "msteams",
+			expBindings: defaultBindings,
+		},
+		{
+			name:        "Already registered conversation",
+			channelID:   "19:registered@thread.tacv2",
+			channelName: "Alerts",
+			expAlias:    "registered",
+			expBindings: registeredBindings,
+			expNotify:   true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ref := schema.ConversationReference{ChannelID: tc.channelID, ServiceURL: "https://smba.trafficmanager.net/emea/"}
+
+			// when
+			conv := b.getConversationFor(ref, tc.channelName)
+
+			// then
+			assert.Equal(t, ref, conv.ref)
+			assert.Equal(t, tc.expAlias, conv.alias)
+			assert.Equal(t, tc.expBindings, conv.bindings)
+			assert.Equal(t, tc.expNotify, conv.notify)
+		})
+	}
+}
+
+func TestTeams_ConversationFromState(t *testing.T) {
+	// given
+	defaultBindings := config.BotBindings{Sources: []string{"k8s-events"}}
+	alertsBindings := config.BotBindings{Sources: []string{"k8s-err-events"}}
+	b := &Teams{
+		bindings: defaultBindings,
+		channels: teamsChannelsConfigFrom(config.IdentifiableMap[config.ChannelBindingsByName]{
+			"alerts": {Name: "Alerts", Bindings: alertsBindings, Notification: config.ChannelNotification{Disabled: true}},
+		}),
+	}
+
+	tests := []struct {
+		name string
+
+		state config.TeamsConversationStartupState
+
+		expAlias    string
+		expBindings config.BotBindings
+		expNotify   bool
+	}{
+		{
+			name: "Configured channel uses its own notifications state",
+			state: config.TeamsConversationStartupState{
+				Alias:     "alerts",
+				Notify:    true,
+				Reference: config.TeamsConversationReference{ChannelID: "19:alerts"},
+			},
+			expAlias:    "alerts",
+			expBindings: alertsBindings,
+			expNotify:   false,
+		},
+		{
+			name: "Not configured conversation uses the persisted notifications state",
+			state: config.TeamsConversationStartupState{
+				Notify:    true,
+				Reference: config.TeamsConversationReference{ChannelID: "19:other"},
+			},
+			expBindings: defaultBindings,
+			expNotify:   true,
+		},
+		{
+			name: "Channel removed from configuration",
+			state: config.TeamsConversationStartupState{
+				Alias:     "removed",
+				Reference: config.TeamsConversationReference{ChannelID: "19:removed"},
+			},
+			expBindings: defaultBindings,
+			expNotify:   false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			conv := b.conversationFromState(tc.state)
+
+			// then
+			assert.Equal(t, tc.state.Reference.ChannelID, conv.ref.ChannelID)
+			assert.Equal(t, tc.expAlias, conv.alias)
+			assert.Equal(t, tc.expBindings, conv.bindings)
+			assert.Equal(t, tc.expNotify, conv.notify)
+		})
+	}
+}
+
+func TestTeams_ProcessMessagePassesSender(t *testing.T) {
+	// given
+	executors := &fakeExecutorFactory{}
+	logger, _ := logtest.NewNullLogger()
+	botMentionRegex, err := teamsBotMentionRegex("BotKube")
+	require.NoError(t, err)
+
+	b := &Teams{
+		log:             logger,
+		executorFactory: executors,
+		botMentionRegex: botMentionRegex,
+		channels:        map[string]channelConfigByName{},
+		conversations:   map[string]conversation{},
+		longFormatter:   interactive.NewMDFormatter(longLineFormatter, interactive.MdHeaderFormatter),
+		shortFormatter:  interactive.NewMDFormatter(shortLineFormatter, interactive.MdHeaderFormatter),
+	}
+	sessions := execute.NewClusterExecutor(logger, nil, execute.NewClusterSessionStore())
+
+	activityFrom := func(userID string) schema.Activity {
+		return schema.Activity{
+			Text:         "<at>BotKube</at> use cluster dev",
+			From:         schema.ChannelAccount{ID: userID},
+			Conversation: schema.ConversationAccount{ID: "19:general@thread.tacv2"},
+			ChannelData:  map[string]interface{}{"teamsChannelId": "19:general@thread.tacv2"},
+		}
+	}
+
+	// when
+	b.processMessage(context.Background(), activityFrom("29:alice"))
+	b.processMessage(context.Background(), activityFrom("29:bob"))
+
+	// then
+	require.Len(t, executors.inputs, 2)
+	assert.Equal(t, "29:alice", executors.inputs[0].User)
+	assert.Equal(t, "29:bob", executors.inputs[1].User)
+
+	var keys []execute.ClusterSessionKey
+	for _, in := range executors.inputs {
+		assert.Equal(t, "19:general@thread.tacv2", in.Conversation.ID)
+		keys = append(keys, sessions.SessionKey(b.commGroupName, b.IntegrationName(), in.Conversation, in.User))
+	}
+	assert.NotEqual(t, keys[0], keys[1])
+}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/knadh/koanf"
@@ -17,6 +18,8 @@ import (
 	"github.com/spf13/pflag"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"k8s.io/client-go/util/jsonpath"
+	"k8s.io/kubectl/pkg/cmd/get"
 )
 
 //go:embed default.yaml
@@ -55,6 +58,8 @@ const (
 	NormalEvent EventType = "normal"
 	// InfoEvent for insignificant Info events
 	InfoEvent EventType = "info"
+	// ResolvedEvent when a previously reported status condition error is resolved
+	ResolvedEvent EventType = "resolved"
 	// AllEvent to watch all events
 	AllEvent EventType = "all"
 )
@@ -75,6 +80,19 @@ const (
 	Critical Level = "critical"
 )
 
+var levelSeverity = map[Level]int{
+	Debug:    0,
+	Info:     1,
+	Warn:     2,
+	Error:    3,
+	Critical: 4,
+}
+
+// IsAtLeast returns true if the level is the same or more severe than a given one.
+func (l Level) IsAtLeast(min Level) bool {
+	return levelSeverity[l] >= levelSeverity[min]
+}
+
 // CommPlatformIntegration defines integrations with communication platforms.
 type CommPlatformIntegration string
 
@@ -128,6 +146,7 @@ type Config struct {
 	Executors      map[string]Executors      `yaml:"executors" validate:"dive"`
 	Communications map[string]Communications `yaml:"communications"  validate:"required,min=1,dive"`
 	Filters        Filters                   `yaml:"filters"`
+	Schedules      map[string]Schedule       `yaml:"schedules,omitempty" validate:"dive"`
 
 	Analytics     Analytics  `yaml:"analytics"`
 	Settings      Settings   `yaml:"settings"`
@@ -162,17 +181,76 @@ func (c ChannelBindingsByID) Identifier() string {
 type BotBindings struct {
 	Sources   []string `yaml:"sources"`
 	Executors []string `yaml:"executors"`
+	Schedules []string `yaml:"schedules,omitempty"`
+	// MinLevel is the minimum level of events sent to a given channel. If not specified, all events are sent.
+	MinLevel Level `yaml:"minLevel,omitempty" validate:"omitempty,oneof=info warn error critical"`
+}
+
+// Schedule contains configuration for reports sent periodically to bound channels.
+type Schedule struct {
+	// Cron is a standard cron expression, e.g. `0 9 * * 1-5`. Descriptors, such as `@daily`, are also supported.
+	Cron string `yaml:"cron" validate:"required"`
+	// Commands are executed with the executor bindings of a given channel.
+	Commands    []string            `yaml:"commands"`
+	EventDigest ScheduleEventDigest `yaml:"eventDigest"`
+}
+
+// ScheduleEventDigest contains configuration for the digest of events sent since the last schedule run.
+type ScheduleEventDigest struct {
+	Enabled bool `yaml:"enabled"`
 }
 
 // SinkBindings contains configuration for possible Sink bindings.
 type SinkBindings struct {
 	Sources []string `yaml:"sources"`
+	// MinLevel is the minimum level of events sent to a given sink. If not specified, all events are sent.
+	MinLevel Level `yaml:"minLevel,omitempty" validate:"omitempty,oneof=info warn error critical"`
 }
 
 // Sources contains configuration for BotKube app sources.
 type Sources struct {
-	DisplayName string           `yaml:"displayName"`
-	Kubernetes  KubernetesSource `yaml:"kubernetes"`
+	DisplayName   string           `yaml:"displayName"`
+	Kubernetes    KubernetesSource `yaml:"kubernetes"`
+	EventTemplate EventTemplate    `yaml:"eventTemplate,omitempty"`
+}
+
+// EventTemplate contains Go templates which override the default event message title and body.
+// The templates are rendered with the event data. Fields of the raw Kubernetes object are available under `.Object`.
+type EventTemplate struct {
+	// Title is a template for the message title. If not specified, the default title is used.
+	Title string `yaml:"title,omitempty"`
+	// Body is a template for the message body. If not specified, the default short message is used.
+	Body string `yaml:"body,omitempty"`
+	// Platforms overrides the title and body templates for given communication platforms, e.g. `slack` or `discord`.
+	Platforms map[CommPlatformIntegration]PlatformEventTemplate `yaml:"platforms,omitempty"`
+}
+
+// PlatformEventTemplate contains Go templates which override the event message title and body for a given communication platform.
+type PlatformEventTemplate struct {
+	Title string `yaml:"title,omitempty"`
+	Body  string `yaml:"body,omitempty"`
+}
+
+// IsEmpty returns true if neither title nor body template is defined.
+func (t EventTemplate) IsEmpty() bool {
+	return t.Title == "" && t.Body == "" && len(t.Platforms) == 0
+}
+
+// ForPlatform returns the title and body templates with the overrides for a given communication platform applied.
+func (t EventTemplate) ForPlatform(platform CommPlatformIntegration) EventTemplate {
+	out := EventTemplate{Title: t.Title, Body: t.Body}
+	override, found := t.Platforms[platform]
+	if !found {
+		return out
+	}
+
+	if override.Title != "" {
+		out.Title = override.Title
+	}
+	if override.Body != "" {
+		out.Body = override.Body
+	}
+	return out
 }
 
 // KubernetesSource contains configuration for Kubernetes sources.
@@ -181,6 +259,19 @@ type KubernetesSource struct {
 	Events          KubernetesResourceEvents `yaml:"events"`
 	Resources       []Resource               `yaml:"resources" validate:"dive"`
 	Namespaces      Namespaces               `yaml:"namespaces"`
+	Logs            PodLogs                  `yaml:"logs"`
+	// Reasons are applied to every resource which doesn't specify its own reasons.
+	Reasons EventReasons `yaml:"reasons,omitempty"`
+}
+
+// PodLogs contains configuration of the container logs attached to Pod error events.
+type PodLogs struct {
+	// Enabled attaches the logs of the failing container to Pod error events.
+	Enabled bool `yaml:"enabled"`
+	// TailLines is the number of the most recent log lines to attach.
+	TailLines int64 `yaml:"tailLines" validate:"omitempty,min=1"`
+	// MaxBytes caps the size of the attached logs.
+	MaxBytes int64 `yaml:"maxBytes" validate:"omitempty,min=1"`
 }
 
 // IsAllowed checks if a given resource event is allowed according to the configuration.
@@ -209,8 +300,77 @@ func (r *KubernetesSource) IsAllowed(resourceName, namespace string, eventType E
 
 // Recommendations contains configuration for various recommendation insights.
 type Recommendations struct {
-	Ingress IngressRecommendations `yaml:"ingress"`
-	Pod     PodRecommendations     `yaml:"pod"`
+	Ingress                 IngressRecommendations                 `yaml:"ingress"`
+	Pod                     PodRecommendations                     `yaml:"pod"`
+	Deployment              DeploymentRecommendations              `yaml:"deployment"`
+	Service                 ServiceRecommendations                 `yaml:"service"`
+	NetworkPolicy           NetworkPolicyRecommendations           `yaml:"networkPolicy"`
+	HorizontalPodAutoscaler HorizontalPodAutoscalerRecommendations `yaml:"horizontalPodAutoscaler"`
+
+	// Custom contains user-defined recommendations evaluated for newly created resources.
+	Custom []CustomRecommendation `yaml:"custom,omitempty" validate:"dive"`
+}
+
+// RecommendationSeverity defines how a recommendation is reported.
+type RecommendationSeverity string
+
+const (
+	// InfoRecommendationSeverity reports a recommendation as an informational one.
+	InfoRecommendationSeverity RecommendationSeverity = "info"
+
+	// WarningRecommendationSeverity reports a recommendation as a warning.
+	WarningRecommendationSeverity RecommendationSeverity = "warning"
+)
+
+// CustomRecommendation contains configuration for a user-defined recommendation.
+type CustomRecommendation struct {
+	// Name identifies the recommendation. Recommendations with the same name defined in later sources override the earlier ones.
+	Name string `yaml:"name" validate:"required"`
+
+	// Resource is the resource name the recommendation is evaluated for, e.g. `apps/v1/deployments`.
+	Resource string `yaml:"resource" validate:"required"`
+
+	// Condition is a JSONPath expression evaluated against the created object.
+	// The recommendation is raised when the expression returns at least one value other than empty or `false`.
+	Condition string `yaml:"condition" validate:"required"`
+
+	// Message is a Go template of the recommendation message. The event fields and the `.Matches` list of values
+	// returned by the condition are available, as well as the `join` function.
+	Message string `yaml:"message" validate:"required"`
+
+	// Severity defines if the recommendation is reported as info or warning. Defaults to info.
+	Severity RecommendationSeverity `yaml:"severity,omitempty" validate:"omitempty,oneof=info warning"`
+}
+
+var customRecommendationMessageFuncs = template.FuncMap{
+	"join": func(sep string, in []string) string {
+		return strings.Join(in, sep)
+	},
+}
+
+// ParseCondition parses the JSONPath condition. Missing keys are allowed, so they don't fail the evaluation.
+func (r CustomRecommendation) ParseCondition() (*jsonpath.JSONPath, error) {
+	expr, err := get.RelaxedJSONPathExpression(r.Condition)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing condition %q: %w", r.Condition, err)
+	}
+
+	condition := jsonpath.New(r.Name).AllowMissingKeys(true)
+	if err := condition.Parse(expr); err != nil {
+		return nil, fmt.Errorf("while parsing condition %q: %w", r.Condition, err)
+	}
+
+	return condition, nil
+}
+
+// ParseMessage parses the message template.
+func (r CustomRecommendation) ParseMessage() (*template.Template, error) {
+	message, err := template.New(r.Name).Funcs(customRecommendationMessageFuncs).Option("missingkey=zero").Parse(r.Message)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing message template: %w", err)
+	}
+
+	return message, nil
 }
 
 // PodRecommendations contains configuration for pods recommendations.
@@ -220,6 +380,24 @@ type PodRecommendations struct {
 
 	// LabelsSet notifies about Pod resources created without labels.
 	LabelsSet *bool `yaml:"labelsSet,omitempty"`
+
+	// ResourcesSet notifies about Pod containers without CPU and memory requests or limits.
+	ResourcesSet *bool `yaml:"resourcesSet,omitempty"`
+
+	// ProbesSet notifies about Pod containers without liveness or readiness probes.
+	ProbesSet *bool `yaml:"probesSet,omitempty"`
+
+	// NoRootOrPrivileged notifies about Pod containers which run as root or in privileged mode.
+	NoRootOrPrivileged *bool `yaml:"noRootOrPrivileged,omitempty"`
+
+	// NoHostPathVolumes notifies about Pod resources which mount hostPath volumes.
+	NoHostPathVolumes *bool `yaml:"noHostPathVolumes,omitempty"`
+}
+
+// DeploymentRecommendations contains configuration for deployments recommendations.
+type DeploymentRecommendations struct {
+	// PodDisruptionBudgetSet notifies about Deployments with multiple replicas which are not covered by any PodDisruptionBudget.
+	PodDisruptionBudgetSet *bool `yaml:"podDisruptionBudgetSet,omitempty"`
 }
 
 // IngressRecommendations contains configuration for ingress recommendations.
@@ -231,6 +409,27 @@ type IngressRecommendations struct {
 	TLSSecretValid *bool `yaml:"tlsSecretValid,omitempty"`
 }
 
+// ServiceRecommendations contains configuration for services recommendations.
+type ServiceRecommendations struct {
+	// SelectorValid notifies about Service resources whose selector matches no Pods.
+	SelectorValid *bool `yaml:"selectorValid,omitempty"`
+
+	// TargetPortValid notifies about Service resources whose target port isn't exposed by any matching Pod.
+	TargetPortValid *bool `yaml:"targetPortValid,omitempty"`
+}
+
+// NetworkPolicyRecommendations contains configuration for network policies recommendations.
+type NetworkPolicyRecommendations struct {
+	// PodSelectorValid notifies about NetworkPolicy resources which select no Pods.
+	PodSelectorValid *bool `yaml:"podSelectorValid,omitempty"`
+}
+
+// HorizontalPodAutoscalerRecommendations contains configuration for horizontal pod autoscalers recommendations.
+type HorizontalPodAutoscalerRecommendations struct {
+	// ScaleTargetValid notifies about HorizontalPodAutoscaler resources with invalid scale target reference.
+	ScaleTargetValid *bool `yaml:"scaleTargetValid,omitempty"`
+}
+
 // Executors contains executors configuration parameters.
 type Executors struct {
 	Kubectl Kubectl `yaml:"kubectl"`
@@ -276,6 +475,133 @@ type Resource struct {
 	Namespaces    Namespaces               `yaml:"namespaces"`
 	Events        KubernetesResourceEvents `yaml:"events"`
 	UpdateSetting UpdateSetting            `yaml:"updateSetting"`
+	Owner         ResourceOwner            `yaml:"owner,omitempty"`
+	Conditions    []ResourceCondition      `yaml:"conditions,omitempty" validate:"dive"`
+	Reasons       EventReasons             `yaml:"reasons,omitempty"`
+}
+
+// EventReasons narrows down the reported Kubernetes Events by their reason, e.g. `BackOff` or `ScalingReplicaSet`.
+// It applies to the error, warning and normal events.
+type EventReasons struct {
+	// Include contains the reasons of reported events. If empty, all reasons are reported.
+	// It can also contain a regex expressions:
+	//  - "Failed.*" - to specify all reasons with `Failed` prefix.
+	Include []string `yaml:"include,omitempty"`
+	// Exclude contains the reasons of ignored events. It takes precedence over Include.
+	// It can also contain a regex expressions:
+	//  - "FailedMount|FailedAttachVolume" - to ignore volume mount retries.
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+// IsConfigured checks whether the reasons are specified.
+func (r EventReasons) IsConfigured() bool {
+	return len(r.Include) > 0 || len(r.Exclude) > 0
+}
+
+// IsAllowed checks if a given Kubernetes Event reason is allowed based on the config.
+// The reasons are matched in the same way as Namespaces, except that empty Include allows all reasons.
+func (r EventReasons) IsAllowed(reason string) bool {
+	return r.Matcher().IsAllowed(reason)
+}
+
+// Matcher returns EventReasonsMatcher with the regexes compiled once, so it can be used for many reasons.
+func (r EventReasons) Matcher() EventReasonsMatcher {
+	return EventReasonsMatcher{
+		hasInclude: len(r.Include) > 0,
+		include:    newPatternMatcher(r.Include),
+		exclude:    newPatternMatcher(r.Exclude),
+	}
+}
+
+// EventReasonsMatcher checks if Kubernetes Event reasons are allowed based on the EventReasons config.
+// The zero value allows all reasons.
+type EventReasonsMatcher struct {
+	hasInclude bool
+	include    patternMatcher
+	exclude    patternMatcher
+}
+
+// IsAllowed checks if a given Kubernetes Event reason is allowed.
+func (m EventReasonsMatcher) IsAllowed(reason string) bool {
+	if m.exclude.matchesAny(reason) {
+		return false
+	}
+
+	if !m.hasInclude {
+		return true
+	}
+	return m.include.matchesAny(reason)
+}
+
+// patternMatcher matches values against patterns which are either exact values or regexes.
+type patternMatcher struct {
+	patterns []string
+	// regexes are indexed in the same way as patterns.
+	regexes []*regexp.Regexp
+}
+
+// newPatternMatcher returns a new patternMatcher with compiled regexes. Empty patterns are skipped.
+func newPatternMatcher(patterns []string) patternMatcher {
+	var out patternMatcher
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			continue
+		}
+
+		// invalid regex is nil, so the pattern is matched only exactly
+		re, _ := regexp.Compile(pattern)
+		out.patterns = append(out.patterns, pattern)
+		out.regexes = append(out.regexes, re)
+	}
+	return out
+}
+
+// matchesAny returns true if a given value is equal to, or matches the regex of any pattern.
+func (m patternMatcher) matchesAny(value string) bool {
+	for i, pattern := range m.patterns {
+		// exact match
+		if pattern == value {
+			return true
+		}
+
+		// regexp
+		if re := m.regexes[i]; re != nil && re.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResourceCondition defines a status condition which is tracked to report errors for a resource.
+// The error event is sent when the condition status changes to False, and the resolved event when it changes back to True.
+type ResourceCondition struct {
+	// Type is the condition type, e.g. Ready.
+	Type string `yaml:"type" validate:"required"`
+}
+
+// ResourceOwner narrows down the watched objects to the ones controlled by a given top-level workload,
+// e.g. Pods owned by a given Deployment.
+type ResourceOwner struct {
+	// Kind is the kind of the top-level workload, e.g. Deployment, StatefulSet, DaemonSet or CronJob.
+	Kind string `yaml:"kind"`
+	// Name is the name of the top-level workload. If empty, all workloads of a given kind are matched.
+	Name string `yaml:"name,omitempty"`
+}
+
+// IsConfigured checks whether the owner is specified.
+func (o ResourceOwner) IsConfigured() bool {
+	return o.Kind != "" || o.Name != ""
+}
+
+// Matches checks if a given top-level workload matches the configured owner.
+func (o ResourceOwner) Matches(kind, name string) bool {
+	if o.Kind != "" && !strings.EqualFold(o.Kind, kind) {
+		return false
+	}
+	if o.Name != "" && o.Name != name {
+		return false
+	}
+	return kind != ""
 }
 
 // KubernetesResourceEvents contains events to watch for a resource.
@@ -303,8 +629,41 @@ func (e *KubernetesResourceEvents) Contains(eventType EventType) bool {
 
 // UpdateSetting struct defines updateEvent fields specification
 type UpdateSetting struct {
-	Fields      []string `yaml:"fields"`
-	IncludeDiff bool     `yaml:"includeDiff"`
+	Fields         []string       `yaml:"fields"`
+	IncludeDiff    bool           `yaml:"includeDiff"`
+	FullObjectDiff FullObjectDiff `yaml:"fullObjectDiff,omitempty"`
+}
+
+// ShouldIncludeDiff returns true if the diff should be included in the event. The full object diff implies it.
+func (u UpdateSetting) ShouldIncludeDiff() bool {
+	return u.IncludeDiff || u.FullObjectDiff.Enabled
+}
+
+// DefaultFullObjectDiffIgnorePaths are paths ignored in the full object diff if not specified otherwise.
+var DefaultFullObjectDiffIgnorePaths = []string{
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.generation",
+	"metadata.annotations.kubectl.kubernetes.io/last-applied-configuration",
+	"status",
+}
+
+// FullObjectDiff contains configuration for the structured diff of the whole object.
+type FullObjectDiff struct {
+	// Enabled turns on the diff of the whole object. If enabled, UpdateSetting.Fields are not used
+	// and the diff is included in the event regardless of UpdateSetting.IncludeDiff.
+	Enabled bool `yaml:"enabled"`
+	// IgnorePaths contains dot-separated paths excluded from the diff, together with all nested fields.
+	// Defaults to DefaultFullObjectDiffIgnorePaths.
+	IgnorePaths []string `yaml:"ignorePaths,omitempty"`
+}
+
+// IgnorePathsOrDefault returns the configured ignore paths, or the default ones if not specified.
+func (d FullObjectDiff) IgnorePathsOrDefault() []string {
+	if d.IgnorePaths == nil {
+		return DefaultFullObjectDiffIgnorePaths
+	}
+	return d.IgnorePaths
 }
 
 // Namespaces provides an option to include and exclude given Namespaces.
@@ -330,48 +689,32 @@ func (n *Namespaces) IsAllowed(givenNs string) bool {
 	if n == nil {
 		return false
 	}
+	return n.Matcher().IsAllowed(givenNs)
+}
 
-	// 1. Check if excluded
-	if len(n.Exclude) > 0 {
-		for _, excludeNamespace := range n.Exclude {
-			if strings.TrimSpace(excludeNamespace) == "" {
-				continue
-			}
-			// exact match
-			if excludeNamespace == givenNs {
-				return false
-			}
-
-			// regexp
-			matched, err := regexp.MatchString(excludeNamespace, givenNs)
-			if err == nil && matched {
-				return false
-			}
-		}
+// Matcher returns NamespacesMatcher with the regexes compiled once, so it can be used for many Namespaces.
+func (n *Namespaces) Matcher() NamespacesMatcher {
+	return NamespacesMatcher{
+		include: newPatternMatcher(n.Include),
+		exclude: newPatternMatcher(n.Exclude),
 	}
+}
 
-	// 2. Check if included, if matched, return true
-	if len(n.Include) > 0 {
-		for _, includeNamespace := range n.Include {
-			if strings.TrimSpace(includeNamespace) == "" {
-				continue
-			}
-
-			// exact match
-			if includeNamespace == givenNs {
-				return true
-			}
+// NamespacesMatcher checks if Namespaces are allowed based on the Namespaces config.
+type NamespacesMatcher struct {
+	include patternMatcher
+	exclude patternMatcher
+}
 
-			// regexp
-			matched, err := regexp.MatchString(includeNamespace, givenNs)
-			if err == nil && matched {
-				return true
-			}
-		}
+// IsAllowed checks if a given Namespace is allowed.
+func (m NamespacesMatcher) IsAllowed(givenNs string) bool {
+	// 1. Check if excluded
+	if m.exclude.matchesAny(givenNs) {
+		return false
 	}
 
-	// 2.1. If not included, return false
-	return false
+	// 2. Check if included. If not included, return false
+	return m.include.matchesAny(givenNs)
 }
 
 // Notification holds notification configuration.
@@ -381,7 +724,142 @@ type Notification struct {
 
 // ChannelNotification contains notification configuration for a given platform.
 type ChannelNotification struct {
-	Disabled bool `yaml:"disabled"`
+	Disabled   bool             `yaml:"disabled"`
+	Mode       NotificationMode `yaml:"mode,omitempty" validate:"omitempty,oneof=realtime digest"`
+	Digest     ChannelDigest    `yaml:"digest,omitempty"`
+	QuietHours QuietHours       `yaml:"quietHours,omitempty"`
+	// Template overrides the event template of the sources bound to a given channel.
+	Template EventTemplate `yaml:"template,omitempty"`
+}
+
+// IsDigest returns true if events should be sent as a periodic digest instead of one by one.
+func (n ChannelNotification) IsDigest() bool {
+	return n.Mode == DigestNotificationMode
+}
+
+// NotificationMode defines how the events are delivered to a given channel.
+type NotificationMode string
+
+const (
+	// RealtimeNotificationMode sends each event as soon as it occurs. It is the default mode.
+	RealtimeNotificationMode NotificationMode = "realtime"
+	// DigestNotificationMode buffers events and sends them grouped in a single message.
+	DigestNotificationMode NotificationMode = "digest"
+)
+
+// DefaultDigestCron is the default cron expression for sending channel digests.
+const DefaultDigestCron = "0 9 * * *"
+
+// ChannelDigest contains configuration for the digest notification mode.
+type ChannelDigest struct {
+	// Cron is a cron expression which defines when the digest is sent. Defaults to DefaultDigestCron.
+	Cron string `yaml:"cron,omitempty"`
+}
+
+// CronOrDefault returns the configured cron expression, or the default one if not specified.
+func (d ChannelDigest) CronOrDefault() string {
+	if d.Cron == "" {
+		return DefaultDigestCron
+	}
+	return d.Cron
+}
+
+const (
+	quietHoursTimeLayout = "15:04"
+
+	// DefaultQuietHoursMinLevel is the default minimum level of events sent during quiet hours.
+	DefaultQuietHoursMinLevel = Error
+)
+
+// QuietHours contains configuration for the time range when only events with a given minimum level are sent to a channel.
+// Other events are held back and sent as a summary once the quiet hours are over.
+type QuietHours struct {
+	// Start is the beginning of quiet hours in the HH:MM format.
+	Start string `yaml:"start"`
+	// End is the end of quiet hours in the HH:MM format. If it is before Start, quiet hours span midnight.
+	End string `yaml:"end"`
+	// Timezone is the IANA time zone name, such as "Europe/Warsaw". Defaults to UTC.
+	Timezone string `yaml:"timezone,omitempty"`
+	// MinLevel is the minimum level of events sent during quiet hours. Defaults to DefaultQuietHoursMinLevel.
+	MinLevel Level `yaml:"minLevel,omitempty" validate:"omitempty,oneof=info warn error critical"`
+}
+
+// IsEnabled returns true if quiet hours are configured.
+func (q QuietHours) IsEnabled() bool {
+	return q.Start != "" && q.End != ""
+}
+
+// MinLevelOrDefault returns the configured minimum level, or the default one if not specified.
+func (q QuietHours) MinLevelOrDefault() Level {
+	if q.MinLevel == "" {
+		return DefaultQuietHoursMinLevel
+	}
+	return q.MinLevel
+}
+
+// Validate returns an error if quiet hours are misconfigured.
+func (q QuietHours) Validate() error {
+	_, _, _, err := q.parse()
+	return err
+}
+
+// IsActive returns true if a given time is within quiet hours.
+func (q QuietHours) IsActive(now time.Time) (bool, error) {
+	if !q.IsEnabled() {
+		return false, nil
+	}
+
+	start, end, loc, err := q.parse()
+	if err != nil {
+		return false, err
+	}
+
+	local := now.In(loc)
+	minuteOfDay := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	if startMinute <= endMinute {
+		return minuteOfDay >= startMinute && minuteOfDay < endMinute, nil
+	}
+
+	// quiet hours span midnight, e.g. 22:00-07:00
+	return minuteOfDay >= startMinute || minuteOfDay < endMinute, nil
+}
+
+// TimezoneOrDefault returns the configured time zone, or UTC if not specified.
+func (q QuietHours) TimezoneOrDefault() string {
+	if q.Timezone == "" {
+		return time.UTC.String()
+	}
+	return q.Timezone
+}
+
+// String returns a human-readable representation of quiet hours.
+func (q QuietHours) String() string {
+	return fmt.Sprintf("%s-%s %s (min level: %s)", q.Start, q.End, q.TimezoneOrDefault(), q.MinLevelOrDefault())
+}
+
+func (q QuietHours) parse() (time.Time, time.Time, *time.Location, error) {
+	start, err := time.Parse(quietHoursTimeLayout, q.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, fmt.Errorf("invalid quiet hours start %q: expected HH:MM format", q.Start)
+	}
+	end, err := time.Parse(quietHoursTimeLayout, q.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, fmt.Errorf("invalid quiet hours end %q: expected HH:MM format", q.End)
+	}
+
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, nil, fmt.Errorf("invalid quiet hours time zone %q: %w", q.Timezone, err)
+	}
+
+	if _, known := levelSeverity[q.MinLevelOrDefault()]; !known {
+		return time.Time{}, time.Time{}, nil, fmt.Errorf("invalid quiet hours minimum level %q", q.MinLevel)
+	}
+
+	return start, end, loc, nil
 }
 
 // Communications contains communication platforms that are supported.
@@ -398,7 +876,7 @@ type Communications struct {
 // Slack configuration to authentication and send notifications
 type Slack struct {
 	Enabled      bool                                   `yaml:"enabled"`
-	Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required_if=Enabled true,omitempty,min=1"`
+	Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required_if=Enabled true,omitempty,min=1,dive"`
 	Notification Notification                           `yaml:"notification,omitempty"`
 	Token        string                                 `yaml:"token,omitempty"`
 }
@@ -406,7 +884,7 @@ type Slack struct {
 // SocketSlack configuration to authentication and send notifications
 type SocketSlack struct {
 	Enabled      bool                                   `yaml:"enabled"`
-	Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required_if=Enabled true,omitempty,min=1"`
+	Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required_if=Enabled true,omitempty,min=1,dive"`
 	Notification Notification                           `yaml:"notification,omitempty"`
 	BotToken     string                                 `yaml:"botToken,omitempty"`
 	AppToken     string                                 `yaml:"appToken,omitempty"`
@@ -447,8 +925,21 @@ type Mattermost struct {
 	URL          string                                 `yaml:"url"`
 	Token        string                                 `yaml:"token"`
 	Team         string                                 `yaml:"team"`
-	Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required_if=Enabled true,omitempty,min=1"`
+	Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"  validate:"required_if=Enabled true,omitempty,min=1,dive"`
 	Notification Notification                           `yaml:"notification,omitempty"`
+	// Interactivity configures the BotKube endpoint used by Mattermost interactive buttons and dialogs.
+	Interactivity MattermostInteractivity `yaml:"interactivity,omitempty"`
+}
+
+// MattermostInteractivity contains configuration for Mattermost interactive messages.
+type MattermostInteractivity struct {
+	Enabled bool `yaml:"enabled"`
+	// URL is the BotKube base URL reachable by the Mattermost server, e.g. http://botkube.botkube:2114.
+	URL  string `yaml:"url" validate:"required_if=Enabled true"`
+	Port string `yaml:"port,omitempty"`
+	// Secret is attached to every button and dialog, and verified for all incoming requests.
+	// If not set, a random secret is generated on startup, so buttons rendered before restart stop working.
+	Secret string `yaml:"secret,omitempty"`
 }
 
 // Teams creds for authentication with MS Teams
@@ -459,8 +950,9 @@ type Teams struct {
 	AppPassword string `yaml:"appPassword,omitempty"`
 	Port        string `yaml:"port"`
 	MessagePath string `yaml:"messagePath,omitempty"`
-	// TODO: Be consistent with other communicators when MS Teams support multiple channels
-	//Channels     IdentifiableMap[ChannelBindingsByName] `yaml:"channels"`
+	// Channels contains per-conversation bindings. The name of a given channel can be either the Teams channel ID or its name.
+	Channels IdentifiableMap[ChannelBindingsByName] `yaml:"channels,omitempty" validate:"omitempty,dive"`
+	// Bindings are used for all conversations which are not specified under Channels.
 	Bindings     BotBindings  `yaml:"bindings"`
 	Notification Notification `yaml:"notification,omitempty"`
 }
@@ -470,16 +962,15 @@ type Discord struct {
 	Enabled      bool                                 `yaml:"enabled"`
 	Token        string                               `yaml:"token"`
 	BotID        string                               `yaml:"botID"`
-	Channels     IdentifiableMap[ChannelBindingsByID] `yaml:"channels"  validate:"required_if=Enabled true,omitempty,min=1"`
+	Channels     IdentifiableMap[ChannelBindingsByID] `yaml:"channels"  validate:"required_if=Enabled true,omitempty,min=1,dive"`
 	Notification Notification                         `yaml:"notification,omitempty"`
 }
 
 // Webhook configuration to send notifications
 type Webhook struct {
-	Enabled bool   `yaml:"enabled"`
-	URL     string `yaml:"url"`
-	// TODO: not used yet.
-	Bindings SinkBindings
+	Enabled  bool         `yaml:"enabled"`
+	URL      string       `yaml:"url"`
+	Bindings SinkBindings `yaml:"bindings"`
 }
 
 // Kubectl configuration for executing commands inside cluster
@@ -518,8 +1009,17 @@ type Settings struct {
 	} `yaml:"log"`
 	InformersResyncPeriod time.Duration `yaml:"informersResyncPeriod"`
 	Kubeconfig            string        `yaml:"kubeconfig"`
+	// EventsResource is the resource watched to report Kubernetes Events, such as errors and warnings of other resources.
+	EventsResource string `yaml:"eventsResource" validate:"omitempty,oneof=v1/events events.k8s.io/v1/events"`
 }
 
+const (
+	// CoreEventsResource is the resource of Kubernetes Events served by the core API.
+	CoreEventsResource = "v1/events"
+	// EventsAPIEventsResource is the resource of Kubernetes Events served by the events.k8s.io/v1 API.
+	EventsAPIEventsResource = "events.k8s.io/v1/events"
+)
+
 // LifecycleServer contains configuration for the server with app lifecycle methods.
 type LifecycleServer struct {
 	Enabled    bool           `yaml:"enabled"`
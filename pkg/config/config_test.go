@@ -1,8 +1,10 @@
 package config_test
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/pflag"
@@ -168,6 +170,73 @@ func TestLoadedConfigValidationErrors(t *testing.T) {
 				testdataFile(t, "empty-executors-communications.yaml"),
 			},
 		},
+		{
+			name: "invalid notification mode",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 1 error occurred:
+					* Key: 'Config.Communications[default-workspace].Slack.Channels[alias].Notification.Mode' Mode must be one of [realtime digest]`),
+			configFiles: []string{
+				testdataFile(t, "invalid-notification-mode.yaml"),
+			},
+		},
+		{
+			name: "invalid quiet hours",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Communications[default-workspace].Slack.Channels[alias].Notification.QuietHours.Start' Start must be in the HH:MM format
+					* Key: 'Config.Communications[default-workspace].Slack.Channels[alias].Notification.QuietHours.Timezone' Timezone must be a valid IANA time zone name`),
+			configFiles: []string{
+				testdataFile(t, "invalid-quiet-hours.yaml"),
+			},
+		},
+		{
+			name: "invalid minimum levels",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Communications[default-workspace].Slack.Channels[alias].Bindings.MinLevel' MinLevel must be one of [info warn error critical]
+					* Key: 'Config.Communications[default-workspace].Webhook.Bindings.MinLevel' MinLevel must be one of [info warn error critical]`),
+			configFiles: []string{
+				testdataFile(t, "invalid-min-level.yaml"),
+			},
+		},
+		{
+			name: "invalid event templates",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Sources[k8s-events].EventTemplate.Title' Title is not a valid Go template
+					* Key: 'Config.Sources[k8s-events].EventTemplate.Platforms[discord].Body' Platforms[discord].Body is not a valid Go template`),
+			configFiles: []string{
+				testdataFile(t, "invalid-event-template.yaml"),
+			},
+		},
+		{
+			name: "invalid custom recommendation",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 2 errors occurred:
+					* Key: 'Config.Sources[k8s-events].Kubernetes.Recommendations.Custom[0].Condition' Condition is not a valid JSONPath expression
+					* Key: 'Config.Sources[k8s-events].Kubernetes.Recommendations.Custom[0].Message' Message is not a valid Go template`),
+			configFiles: []string{
+				testdataFile(t, "invalid-custom-recommendation.yaml"),
+			},
+		},
+		{
+			name: "invalid schedule cron",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 1 error occurred:
+					* Key: 'Config.Schedules[daily-report].Cron' Cron must be a valid cron expression`),
+			configFiles: []string{
+				testdataFile(t, "invalid-schedule-cron.yaml"),
+			},
+		},
+		{
+			name: "invalid digest cron",
+			expErrMsg: heredoc.Doc(`
+				found critical validation errors: 1 error occurred:
+					* Key: 'Config.Communications[default-workspace].Slack.Channels[alias].Notification.Digest.Cron' Cron must be a valid cron expression`),
+			configFiles: []string{
+				testdataFile(t, "invalid-digest-cron.yaml"),
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -270,6 +339,143 @@ func TestIsNamespaceAllowed(t *testing.T) {
 	}
 }
 
+func TestResourceOwnerMatches(t *testing.T) {
+	tests := map[string]struct {
+		owner    config.ResourceOwner
+		kind     string
+		name     string
+		expected bool
+	}{
+		"should match kind and name": {
+			owner:    config.ResourceOwner{Kind: "Deployment", Name: "api"},
+			kind:     "Deployment",
+			name:     "api",
+			expected: true,
+		},
+		"should match kind case-insensitively": {
+			owner:    config.ResourceOwner{Kind: "deployment"},
+			kind:     "Deployment",
+			name:     "api",
+			expected: true,
+		},
+		"should not match other name": {
+			owner:    config.ResourceOwner{Kind: "Deployment", Name: "web"},
+			kind:     "Deployment",
+			name:     "api",
+			expected: false,
+		},
+		"should not match other kind": {
+			owner:    config.ResourceOwner{Kind: "StatefulSet"},
+			kind:     "Deployment",
+			name:     "api",
+			expected: false,
+		},
+		"should not match object without owner": {
+			owner:    config.ResourceOwner{Kind: "Deployment"},
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.owner.Matches(test.kind, test.name))
+		})
+	}
+}
+
+func TestQuietHoursIsActive(t *testing.T) {
+	tests := map[string]struct {
+		quietHours config.QuietHours
+		now        time.Time
+		isActive   bool
+	}{
+		"should be inactive when not configured": {
+			quietHours: config.QuietHours{},
+			now:        time.Date(2022, 9, 1, 23, 0, 0, 0, time.UTC),
+			isActive:   false,
+		},
+		"should be active within the same day range": {
+			quietHours: config.QuietHours{Start: "12:00", End: "13:00"},
+			now:        time.Date(2022, 9, 1, 12, 30, 0, 0, time.UTC),
+			isActive:   true,
+		},
+		"should be inactive at the end of the range": {
+			quietHours: config.QuietHours{Start: "12:00", End: "13:00"},
+			now:        time.Date(2022, 9, 1, 13, 0, 0, 0, time.UTC),
+			isActive:   false,
+		},
+		"should be active before midnight for range spanning midnight": {
+			quietHours: config.QuietHours{Start: "22:00", End: "07:00"},
+			now:        time.Date(2022, 9, 1, 23, 0, 0, 0, time.UTC),
+			isActive:   true,
+		},
+		"should be active after midnight for range spanning midnight": {
+			quietHours: config.QuietHours{Start: "22:00", End: "07:00"},
+			now:        time.Date(2022, 9, 1, 6, 59, 0, 0, time.UTC),
+			isActive:   true,
+		},
+		"should be inactive during the day for range spanning midnight": {
+			quietHours: config.QuietHours{Start: "22:00", End: "07:00"},
+			now:        time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC),
+			isActive:   false,
+		},
+		"should respect the time zone": {
+			quietHours: config.QuietHours{Start: "22:00", End: "07:00", Timezone: "Europe/Warsaw"},
+			now:        time.Date(2022, 9, 1, 21, 0, 0, 0, time.UTC), // 23:00 in Warsaw
+			isActive:   true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			actual, err := test.quietHours.IsActive(test.now)
+			require.NoError(t, err)
+			assert.Equal(t, test.isActive, actual)
+		})
+	}
+}
+
+func TestQuietHoursValidate(t *testing.T) {
+	tests := map[string]struct {
+		quietHours     config.QuietHours
+		expErrMessages string
+	}{
+		"should accept valid configuration": {
+			quietHours: config.QuietHours{Start: "22:00", End: "07:00", Timezone: "America/New_York", MinLevel: config.Critical},
+		},
+		"should reject invalid start": {
+			quietHours:     config.QuietHours{Start: "10pm", End: "07:00"},
+			expErrMessages: `invalid quiet hours start "10pm": expected HH:MM format`,
+		},
+		"should reject invalid end": {
+			quietHours:     config.QuietHours{Start: "22:00", End: "25:00"},
+			expErrMessages: `invalid quiet hours end "25:00": expected HH:MM format`,
+		},
+		"should reject unknown level": {
+			quietHours:     config.QuietHours{Start: "22:00", End: "07:00", MinLevel: "fatal"},
+			expErrMessages: `invalid quiet hours minimum level "fatal"`,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			err := test.quietHours.Validate()
+			if test.expErrMessages == "" {
+				require.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.expErrMessages)
+		})
+	}
+}
+
+func TestLevelIsAtLeast(t *testing.T) {
+	assert.True(t, config.Critical.IsAtLeast(config.Error))
+	assert.True(t, config.Error.IsAtLeast(config.Error))
+	assert.False(t, config.Warn.IsAtLeast(config.Error))
+	assert.False(t, config.Debug.IsAtLeast(config.Info))
+}
+
 func TestSortCfgFiles(t *testing.T) {
 	tests := map[string]struct {
 		input    []string
@@ -293,3 +499,82 @@ func TestSortCfgFiles(t *testing.T) {
 		})
 	}
 }
+
+func TestWithWatcherSyncedFiles(t *testing.T) {
+	// given
+	tmpDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "comm_config.yaml"), []byte("{}"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(tmpDir, "global_config.yaml"), 0o700))
+
+	getCfgPaths := func() []string {
+		return []string{"/config/global_config.yaml", "/config/comm_config.yaml", "/config/sources.yaml"}
+	}
+	expected := []string{"/config/global_config.yaml", filepath.Join(tmpDir, "comm_config.yaml"), "/config/sources.yaml"}
+
+	// when
+	actual := config.WithWatcherSyncedFiles(tmpDir, getCfgPaths)()
+
+	// then
+	assert.Equal(t, expected, actual)
+}
+
+func TestEventReasonsIsAllowed(t *testing.T) {
+	tests := map[string]struct {
+		reasons  config.EventReasons
+		reason   string
+		expected bool
+	}{
+		"should allow any reason if not configured": {
+			reasons:  config.EventReasons{},
+			reason:   "BackOff",
+			expected: true,
+		},
+		"should allow included reason": {
+			reasons:  config.EventReasons{Include: []string{"ScalingReplicaSet", "Killing"}},
+			reason:   "Killing",
+			expected: true,
+		},
+		"should not allow reason which is not included": {
+			reasons:  config.EventReasons{Include: []string{"ScalingReplicaSet", "Killing"}},
+			reason:   "Pulled",
+			expected: false,
+		},
+		"should not allow excluded reason": {
+			reasons:  config.EventReasons{Exclude: []string{"Pulled"}},
+			reason:   "Pulled",
+			expected: false,
+		},
+		"should prefer exclude over include": {
+			reasons:  config.EventReasons{Include: []string{"Pulled"}, Exclude: []string{"Pulled"}},
+			reason:   "Pulled",
+			expected: false,
+		},
+		"should allow reason included by regex": {
+			reasons:  config.EventReasons{Include: []string{"Failed.*"}},
+			reason:   "FailedScheduling",
+			expected: true,
+		},
+		"should not allow reason excluded by regex": {
+			reasons:  config.EventReasons{Include: []string{"Failed.*"}, Exclude: []string{"FailedMount|FailedAttachVolume"}},
+			reason:   "FailedMount",
+			expected: false,
+		},
+		"should ignore empty patterns": {
+			reasons:  config.EventReasons{Include: []string{""}},
+			reason:   "BackOff",
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.reasons.IsAllowed(test.reason))
+			assert.Equal(t, test.expected, test.reasons.Matcher().IsAllowed(test.reason))
+		})
+	}
+}
+
+func TestEventReasonsMatcherZeroValueAllowsAll(t *testing.T) {
+	var matcher config.EventReasonsMatcher
+	assert.True(t, matcher.IsAllowed("BackOff"))
+}
@@ -64,7 +64,8 @@ func (m *PersistenceManager) PersistSourceBindings(ctx context.Context, commGrou
 		state.Communications[commGroupName][platform] = platformCfg
 	}
 
-	if platform == TeamsCommPlatformIntegration {
+	// MS Teams conversations which are not configured under channels use the default bindings.
+	if platform == TeamsCommPlatformIntegration && channelAlias == "" {
 		if platformCfg.MSTeamsOnlyRuntimeState == nil {
 			platformCfg.MSTeamsOnlyRuntimeState = &ChannelRuntimeState{}
 		}
@@ -101,20 +102,88 @@ func (m *PersistenceManager) PersistSourceBindings(ctx context.Context, commGrou
 	return nil
 }
 
+// PersistTeamsConversation persists a given MS Teams conversation, so it can be restored after BotKube restart.
+// The conversation is stored in the startup state, so persisting it doesn't trigger BotKube reload.
+func (m *PersistenceManager) PersistTeamsConversation(ctx context.Context, commGroupName string, conversation TeamsConversationStartupState) error {
+	cmStorage := configMapStorage[StartupState]{k8sCli: m.k8sCli, cfg: m.cfg.Startup}
+
+	state, cm, err := cmStorage.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if state.Communications == nil {
+		state.Communications = make(map[string]CommunicationsStartupState)
+	}
+	commGroup, exists := state.Communications[commGroupName]
+	if !exists {
+		commGroup = make(CommunicationsStartupState)
+		state.Communications[commGroupName] = commGroup
+	}
+
+	platformCfg := commGroup[TeamsCommPlatformIntegration]
+	if platformCfg.MSTeamsOnlyConversations == nil {
+		platformCfg.MSTeamsOnlyConversations = make(map[string]TeamsConversationStartupState)
+	}
+
+	platformCfg.MSTeamsOnlyConversations[conversation.Reference.ChannelID] = conversation
+	commGroup[TeamsCommPlatformIntegration] = platformCfg
+
+	err = cmStorage.Update(ctx, cm, state)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListTeamsConversations returns MS Teams conversations persisted for a given communication group, indexed by the channel ID.
+func (m *PersistenceManager) ListTeamsConversations(ctx context.Context, commGroupName string) (map[string]TeamsConversationStartupState, error) {
+	cmStorage := configMapStorage[StartupState]{k8sCli: m.k8sCli, cfg: m.cfg.Startup}
+
+	state, _, err := cmStorage.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.Communications[commGroupName][TeamsCommPlatformIntegration].MSTeamsOnlyConversations, nil
+}
+
 // PersistNotificationsEnabled persists notifications state for a given channel.
 // While this method updates the BotKube ConfigMap, it doesn't reload BotKube itself.
 func (m *PersistenceManager) PersistNotificationsEnabled(ctx context.Context, commGroupName string, platform CommPlatformIntegration, channelAlias string, enabled bool) error {
+	return m.updateChannelStartupState(ctx, commGroupName, platform, channelAlias, func(channel *ChannelStartupState) {
+		channel.Notification.Disabled = !enabled
+	})
+}
+
+// PersistQuietHours persists quiet hours for a given channel. Empty quiet hours disable them.
+// While this method updates the BotKube ConfigMap, it doesn't reload BotKube itself.
+func (m *PersistenceManager) PersistQuietHours(ctx context.Context, commGroupName string, platform CommPlatformIntegration, channelAlias string, quietHours QuietHours) error {
+	return m.updateChannelStartupState(ctx, commGroupName, platform, channelAlias, func(channel *ChannelStartupState) {
+		channel.Notification.QuietHours = quietHours
+	})
+}
+
+func (m *PersistenceManager) updateChannelStartupState(ctx context.Context, commGroupName string, platform CommPlatformIntegration, channelAlias string, updateFn func(channel *ChannelStartupState)) error {
 	supportedPlatforms := []string{
 		string(SlackCommPlatformIntegration),
 		string(SocketSlackCommPlatformIntegration),
 		string(DiscordCommPlatformIntegration),
 		string(MattermostCommPlatformIntegration),
+		string(TeamsCommPlatformIntegration),
 	}
 
 	if !slices.Contains(supportedPlatforms, string(platform)) {
 		return ErrUnsupportedPlatform
 	}
 
+	// MS Teams conversations which are not configured under channels are registered dynamically,
+	// so there is no channel entry to persist the notifications state in.
+	if platform == TeamsCommPlatformIntegration && channelAlias == "" {
+		return ErrUnsupportedPlatform
+	}
+
 	cmStorage := configMapStorage[StartupState]{k8sCli: m.k8sCli, cfg: m.cfg.Startup}
 	state, cm, err := cmStorage.Get(ctx)
 	if err != nil {
@@ -146,7 +215,7 @@ func (m *PersistenceManager) PersistNotificationsEnabled(ctx context.Context, co
 		channel = ChannelStartupState{}
 	}
 
-	channel.Notification.Disabled = !enabled
+	updateFn(&channel)
 	state.Communications[commGroupName][platform].Channels[channelAlias] = channel
 
 	err = cmStorage.Update(ctx, cm, state)
@@ -72,7 +72,7 @@ func TestPersistenceManager_PersistSourceBindings(t *testing.T) {
 		{
 			Name:                "Empty state files - MS Teams",
 			InputPlatform:       config.TeamsCommPlatformIntegration,
-			InputChannel:        "foo",
+			InputChannel:        "",
 			InputSourceBindings: []string{"first", "second"},
 			InputCfgMap: &v1.ConfigMap{
 				ObjectMeta: metav1.ObjectMeta{
@@ -215,10 +215,17 @@ func TestPersistenceManager_PersistSourceBindings(t *testing.T) {
                                     - older
                                     - oldest
                           teams:
+                            channels:
+                              anything:
+                                bindings:
+                                  sources:
+                                    - new
+                                    - newer
                             bindings:
                               sources:
-                                - new
-                                - newer
+                                - old
+                                - older
+                                - oldest
 					`),
 				},
 			},
@@ -379,7 +386,7 @@ func TestPersistenceManager_PersistNotificationsEnabled(t *testing.T) {
 		},
 		{
 			Name:          "Unsupported platform",
-			InputPlatform: config.TeamsCommPlatformIntegration,
+			InputPlatform: config.WebhookCommPlatformIntegration,
 			InputChannel:  "foo",
 			InputEnabled:  false,
 			InputCfgMap: &v1.ConfigMap{
@@ -390,6 +397,19 @@ func TestPersistenceManager_PersistNotificationsEnabled(t *testing.T) {
 			},
 			ExpectedErrMessage: `unsupported platform to persist data`,
 		},
+		{
+			Name:          "MS Teams conversation without channel configuration",
+			InputPlatform: config.TeamsCommPlatformIntegration,
+			InputChannel:  "",
+			InputEnabled:  false,
+			InputCfgMap: &v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cfg.ConfigMap.Name,
+					Namespace: cfg.ConfigMap.Namespace,
+				},
+			},
+			ExpectedErrMessage: `unsupported platform to persist data`,
+		},
 		{
 			Name:               "No ConfigMap",
 			InputChannel:       "foo",
@@ -425,6 +445,107 @@ func TestPersistenceManager_PersistNotificationsEnabled(t *testing.T) {
 	}
 }
 
+func TestPersistenceManager_PersistQuietHours(t *testing.T) {
+	// given
+	commGroupName := "default-group"
+	cfg := config.PartialPersistentConfig{
+		ConfigMap: config.K8sResourceRef{
+			Name:      "foo",
+			Namespace: "ns",
+		},
+		FileName: "__startup_state.yaml",
+	}
+	cfgMapWithData := func(data string) *v1.ConfigMap {
+		return &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cfg.ConfigMap.Name,
+				Namespace: cfg.ConfigMap.Namespace,
+			},
+			Data: map[string]string{
+				cfg.FileName: data,
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name            string
+		InputCfgMap     *v1.ConfigMap
+		InputQuietHours config.QuietHours
+		Expected        *v1.ConfigMap
+	}{
+		{
+			Name: "Set quiet hours",
+			InputCfgMap: cfgMapWithData(heredoc.Doc(`
+				communications:
+				  default-group:
+				    slack:
+				      channels:
+				        general:
+				          notification:
+				            disabled: true
+			`)),
+			InputQuietHours: config.QuietHours{Start: "22:00", End: "07:00", Timezone: "Europe/Warsaw", MinLevel: config.Critical},
+			Expected: cfgMapWithData(heredoc.Doc(`
+				communications:
+				  default-group:
+				    slack:
+				      channels:
+				        general:
+				          notification:
+				            disabled: true
+				            quietHours:
+				              start: "22:00"
+				              end: "07:00"
+				              timezone: Europe/Warsaw
+				              minLevel: critical
+			`)),
+		},
+		{
+			Name: "Disable quiet hours",
+			InputCfgMap: cfgMapWithData(heredoc.Doc(`
+				communications:
+				  default-group:
+				    slack:
+				      channels:
+				        general:
+				          notification:
+				            disabled: false
+				            quietHours:
+				              start: "22:00"
+				              end: "07:00"
+			`)),
+			InputQuietHours: config.QuietHours{},
+			Expected: cfgMapWithData(heredoc.Doc(`
+				communications:
+				  default-group:
+				    slack:
+				      channels:
+				        general:
+				          notification:
+				            disabled: false
+			`)),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			logger, _ := logtest.NewNullLogger()
+			k8sCli := fake.NewSimpleClientset(testCase.InputCfgMap)
+			manager := config.NewManager(logger, config.PersistentConfig{Startup: cfg}, k8sCli)
+
+			// when
+			err := manager.PersistQuietHours(context.Background(), commGroupName, config.SlackCommPlatformIntegration, "general", testCase.InputQuietHours)
+
+			// then
+			require.NoError(t, err)
+
+			cfgMap, err := k8sCli.CoreV1().ConfigMaps(cfg.ConfigMap.Namespace).Get(context.Background(), cfg.ConfigMap.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, cfgMap)
+		})
+	}
+}
+
 func TestPersistenceManager_PersistFilterEnabled(t *testing.T) {
 	// given
 	cfg := config.PartialPersistentConfig{
@@ -563,3 +684,89 @@ func TestPersistenceManager_PersistFilterEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestPersistenceManager_PersistTeamsConversation(t *testing.T) {
+	// given
+	commGroupName := "default-group"
+	cfg := config.PartialPersistentConfig{
+		ConfigMap: config.K8sResourceRef{
+			Name:      "foo",
+			Namespace: "ns",
+		},
+		FileName: "__startup_state.yaml",
+	}
+	inputCfgMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cfg.ConfigMap.Name,
+			Namespace: cfg.ConfigMap.Namespace,
+		},
+		Data: map[string]string{
+			cfg.FileName: heredoc.Doc(`
+              communications:
+                default-group:
+                  teams:
+                    channels:
+                      alerts:
+                        notification:
+                          disabled: true
+			`),
+		},
+	}
+	conversation := config.TeamsConversationStartupState{
+		Alias: "alerts",
+		Reference: config.TeamsConversationReference{
+			ChannelID:      "19:channel",
+			ServiceURL:     "https://smba.trafficmanager.net/emea/",
+			ConversationID: "19:channel",
+			BotID:          "28:bot",
+		},
+	}
+	expected := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cfg.ConfigMap.Name,
+			Namespace: cfg.ConfigMap.Namespace,
+		},
+		Data: map[string]string{
+			cfg.FileName: heredoc.Doc(`
+              communications:
+                default-group:
+                  teams:
+                    channels:
+                      alerts:
+                        notification:
+                          disabled: true
+                    conversations:
+                      19:channel:
+                        alias: alerts
+                        reference:
+                          channelID: 19:channel
+                          serviceURL: https://smba.trafficmanager.net/emea/
+                          conversationID: 19:channel
+                          botID: 28:bot
+			`),
+		},
+	}
+
+	logger, _ := logtest.NewNullLogger()
+	k8sCli := fake.NewSimpleClientset(inputCfgMap)
+	manager := config.NewManager(logger, config.PersistentConfig{Startup: cfg}, k8sCli)
+
+	// when
+	err := manager.PersistTeamsConversation(context.Background(), commGroupName, conversation)
+
+	// then
+	require.NoError(t, err)
+
+	cfgMap, err := k8sCli.CoreV1().ConfigMaps(cfg.ConfigMap.Namespace).Get(context.Background(), cfg.ConfigMap.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, expected, cfgMap)
+
+	// when
+	conversations, err := manager.ListTeamsConversations(context.Background(), commGroupName)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, map[string]config.TeamsConversationStartupState{
+		"19:channel": conversation,
+	}, conversations)
+}
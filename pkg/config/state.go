@@ -58,7 +58,35 @@ type CommunicationsStartupState map[CommPlatformIntegration]BotStartupState
 
 // BotStartupState represents the startup state for a bot.
 type BotStartupState struct {
-	Channels map[string]ChannelStartupState `yaml:"channels"`
+	Channels map[string]ChannelStartupState `yaml:"channels,omitempty"`
+
+	// MSTeamsOnlyConversations holds MS Teams conversations, indexed by the channel ID. Ignored for other communication platforms.
+	MSTeamsOnlyConversations map[string]TeamsConversationStartupState `yaml:"conversations,omitempty"`
+}
+
+// TeamsConversationStartupState represents the startup state for an MS Teams conversation.
+// The notifications state is stored only for conversations which are not configured under channels,
+// as configured channels keep it in their own startup state.
+type TeamsConversationStartupState struct {
+	Alias      string                     `yaml:"alias,omitempty"`
+	Notify     bool                       `yaml:"notify,omitempty"`
+	QuietHours QuietHours                 `yaml:"quietHours,omitempty"`
+	Reference  TeamsConversationReference `yaml:"reference"`
+}
+
+// TeamsConversationReference holds the MS Teams conversation details required to send proactive messages.
+type TeamsConversationReference struct {
+	ActivityID       string `yaml:"activityID,omitempty"`
+	ChannelID        string `yaml:"channelID"`
+	ServiceURL       string `yaml:"serviceURL"`
+	ConversationID   string `yaml:"conversationID"`
+	ConversationType string `yaml:"conversationType,omitempty"`
+	IsGroup          bool   `yaml:"isGroup,omitempty"`
+	TenantID         string `yaml:"tenantID,omitempty"`
+	BotID            string `yaml:"botID,omitempty"`
+	BotName          string `yaml:"botName,omitempty"`
+	UserID           string `yaml:"userID,omitempty"`
+	UserName         string `yaml:"userName,omitempty"`
 }
 
 // ChannelStartupState represents the startup state for a channel.
@@ -68,7 +96,8 @@ type ChannelStartupState struct {
 
 // NotificationStartupState represents the startup state for a notification.
 type NotificationStartupState struct {
-	Disabled bool `yaml:"disabled"`
+	Disabled   bool       `yaml:"disabled"`
+	QuietHours QuietHours `yaml:"quietHours,omitempty"`
 }
 
 func marshalToMap(in interface{}, propertyName string) (map[string]string, error) {
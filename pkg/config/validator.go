@@ -2,17 +2,27 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-playground/locales/en"
 	ut "github.com/go-playground/universal-translator"
 	"github.com/go-playground/validator/v10"
 	en_translations "github.com/go-playground/validator/v10/translations/en"
 	"github.com/hashicorp/go-multierror"
+	"github.com/robfig/cron/v3"
 
+	"github.com/kubeshop/botkube/pkg/format/eventtemplate"
 	multierrx "github.com/kubeshop/botkube/pkg/multierror"
 )
 
-const nsIncludeTag = "ns-include-regex"
+const (
+	nsIncludeTag          = "ns-include-regex"
+	quietHoursTimeTag     = "quiet-hours-time"
+	quietHoursTimezoneTag = "quiet-hours-timezone"
+	goTemplateTag         = "go-template"
+	jsonPathTag           = "jsonpath"
+	cronTag               = "cron"
+)
 
 var warnsOnlyTags = map[string]struct{}{
 	nsIncludeTag: {},
@@ -37,6 +47,22 @@ func ValidateStruct(in any) (ValidateResult, error) {
 		return ValidateResult{}, err
 	}
 
+	if err := registerQuietHoursValidator(validate, trans); err != nil {
+		return ValidateResult{}, err
+	}
+
+	if err := registerEventTemplateValidator(validate, trans); err != nil {
+		return ValidateResult{}, err
+	}
+
+	if err := registerCustomRecommendationValidator(validate, trans); err != nil {
+		return ValidateResult{}, err
+	}
+
+	if err := registerCronValidator(validate, trans); err != nil {
+		return ValidateResult{}, err
+	}
+
 	err := validate.Struct(in)
 	if err == nil {
 		return ValidateResult{}, nil
@@ -100,6 +126,150 @@ func namespacesStructValidator(sl validator.StructLevel) {
 	}
 }
 
+func registerQuietHoursValidator(validate *validator.Validate, trans ut.Translator) error {
+	validate.RegisterStructValidation(quietHoursStructValidator, QuietHours{})
+
+	translations := map[string]string{
+		quietHoursTimeTag:     "{0} must be in the HH:MM format",
+		quietHoursTimezoneTag: "{0} must be a valid IANA time zone name",
+	}
+	for tag, text := range translations {
+		tag, text := tag, text
+		registerFn := func(ut ut.Translator) error {
+			return ut.Add(tag, text, false)
+		}
+		if err := validate.RegisterTranslation(tag, trans, registerFn, translateFunc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func quietHoursStructValidator(sl validator.StructLevel) {
+	quietHours, ok := sl.Current().Interface().(QuietHours)
+	if !ok {
+		return
+	}
+
+	if quietHours.Start == "" && quietHours.End == "" && quietHours.Timezone == "" {
+		return
+	}
+
+	if _, err := time.Parse(quietHoursTimeLayout, quietHours.Start); err != nil {
+		sl.ReportError(quietHours.Start, "Start", "Start", quietHoursTimeTag, "")
+	}
+	if _, err := time.Parse(quietHoursTimeLayout, quietHours.End); err != nil {
+		sl.ReportError(quietHours.End, "End", "End", quietHoursTimeTag, "")
+	}
+	if _, err := time.LoadLocation(quietHours.Timezone); err != nil {
+		sl.ReportError(quietHours.Timezone, "Timezone", "Timezone", quietHoursTimezoneTag, "")
+	}
+}
+
+func registerEventTemplateValidator(validate *validator.Validate, trans ut.Translator) error {
+	validate.RegisterStructValidation(eventTemplateStructValidator, EventTemplate{})
+
+	registerFn := func(ut ut.Translator) error {
+		return ut.Add(goTemplateTag, "{0} is not a valid Go template", false)
+	}
+
+	return validate.RegisterTranslation(goTemplateTag, trans, registerFn, translateFunc)
+}
+
+func eventTemplateStructValidator(sl validator.StructLevel) {
+	tpl, ok := sl.Current().Interface().(EventTemplate)
+	if !ok {
+		return
+	}
+
+	validateText := func(text, fieldName string) {
+		if text == "" {
+			return
+		}
+		if _, err := eventtemplate.Parse(text); err != nil {
+			sl.ReportError(text, fieldName, fieldName, goTemplateTag, "")
+		}
+	}
+
+	validateText(tpl.Title, "Title")
+	validateText(tpl.Body, "Body")
+	for platform, override := range tpl.Platforms {
+		validateText(override.Title, fmt.Sprintf("Platforms[%s].Title", platform))
+		validateText(override.Body, fmt.Sprintf("Platforms[%s].Body", platform))
+	}
+}
+
+func registerCustomRecommendationValidator(validate *validator.Validate, trans ut.Translator) error {
+	// NOTE: the translation for the Go template tag is registered together with the event template validator.
+	validate.RegisterStructValidation(customRecommendationStructValidator, CustomRecommendation{})
+
+	registerFn := func(ut ut.Translator) error {
+		return ut.Add(jsonPathTag, "{0} is not a valid JSONPath expression", false)
+	}
+
+	return validate.RegisterTranslation(jsonPathTag, trans, registerFn, translateFunc)
+}
+
+func customRecommendationStructValidator(sl validator.StructLevel) {
+	recomm, ok := sl.Current().Interface().(CustomRecommendation)
+	if !ok {
+		return
+	}
+
+	if recomm.Condition != "" {
+		if _, err := recomm.ParseCondition(); err != nil {
+			sl.ReportError(recomm.Condition, "Condition", "Condition", jsonPathTag, "")
+		}
+	}
+	if recomm.Message != "" {
+		if _, err := recomm.ParseMessage(); err != nil {
+			sl.ReportError(recomm.Message, "Message", "Message", goTemplateTag, "")
+		}
+	}
+}
+
+func registerCronValidator(validate *validator.Validate, trans ut.Translator) error {
+	validate.RegisterStructValidation(scheduleStructValidator, Schedule{})
+	validate.RegisterStructValidation(channelDigestStructValidator, ChannelDigest{})
+
+	registerFn := func(ut ut.Translator) error {
+		return ut.Add(cronTag, "{0} must be a valid cron expression", false)
+	}
+
+	return validate.RegisterTranslation(cronTag, trans, registerFn, translateFunc)
+}
+
+func scheduleStructValidator(sl validator.StructLevel) {
+	schedule, ok := sl.Current().Interface().(Schedule)
+	if !ok {
+		return
+	}
+
+	// empty cron is reported by the `required` tag
+	if schedule.Cron == "" {
+		return
+	}
+	if _, err := cron.ParseStandard(schedule.Cron); err != nil {
+		sl.ReportError(schedule.Cron, "Cron", "Cron", cronTag, "")
+	}
+}
+
+func channelDigestStructValidator(sl validator.StructLevel) {
+	channelDigest, ok := sl.Current().Interface().(ChannelDigest)
+	if !ok {
+		return
+	}
+
+	// empty cron defaults to DefaultDigestCron
+	if channelDigest.Cron == "" {
+		return
+	}
+	if _, err := cron.ParseStandard(channelDigest.Cron); err != nil {
+		sl.ReportError(channelDigest.Cron, "Cron", "Cron", cronTag, "")
+	}
+}
+
 // copied from: https://github.com/go-playground/validator/blob/9e2ea4038020b5c7e3802a21cfa4e3afcfdcd276/translations/en/en.go#L1391-L1399
 func translateFunc(ut ut.Translator, fe validator.FieldError) string {
 	t, err := ut.T(fe.Tag(), fe.Field())
@@ -3,6 +3,7 @@ package config
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -42,3 +43,26 @@ func WaitForWatcherSync(ctx context.Context, log logrus.FieldLogger, cfg CfgWatc
 
 	return err
 }
+
+// WithWatcherSyncedFiles returns configuration paths where files synchronized by the Config Watcher replace the mounted ones.
+// The mounted ConfigMaps and Secrets are updated by kubelet with a delay, while the Config Watcher writes the files
+// to its directory before it requests the configuration reload. Paths without a synchronized file are returned unchanged.
+func WithWatcherSyncedFiles(tmpDir string, getCfgPaths PathsGetter) PathsGetter {
+	return func() []string {
+		paths := getCfgPaths()
+		if tmpDir == "" {
+			return paths
+		}
+
+		out := make([]string, 0, len(paths))
+		for _, path := range paths {
+			synced := filepath.Join(tmpDir, filepath.Base(path))
+			if info, err := os.Stat(synced); err == nil && !info.IsDir() {
+				out = append(out, synced)
+				continue
+			}
+			out = append(out, path)
+		}
+		return out
+	}
+}
@@ -2,12 +2,16 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
@@ -22,6 +26,7 @@ import (
 	"github.com/kubeshop/botkube/pkg/recommendation"
 	"github.com/kubeshop/botkube/pkg/sources"
 	"github.com/kubeshop/botkube/pkg/utils"
+	"github.com/kubeshop/botkube/pkg/workload"
 )
 
 const (
@@ -51,32 +56,76 @@ type RecommendationFactory interface {
 	NewForSources(sources map[string]config.Sources, mapKeyOrder []string) (recommendation.AggregatedRunner, config.Recommendations)
 }
 
+// PodLogsFetcher attaches the container logs to Pod error events.
+type PodLogsFetcher interface {
+	AttachToEvent(ctx context.Context, event *events.Event, cfg config.PodLogs) error
+}
+
+// EventRecorder records events sent to notifiers.
+type EventRecorder interface {
+	RecordEvent(event events.Event, sources []string)
+}
+
+// mappedEvents describes the event types which are reported based on Kubernetes Events of a given type.
+// Both error and warning events are based on Warning Kubernetes Events, so for sources watching both of them,
+// the router reports such Kubernetes Event only as the warning one.
+var mappedEvents = []struct {
+	srcEvent config.EventType
+	dstEvent config.EventType
+}{
+	{srcEvent: config.ErrorEvent, dstEvent: config.WarningEvent},
+	{srcEvent: config.WarningEvent, dstEvent: config.WarningEvent},
+	{srcEvent: config.NormalEvent, dstEvent: config.NormalEvent},
+}
+
 // Controller watches Kubernetes resources and send events to notifiers.
 type Controller struct {
 	log                   logrus.FieldLogger
 	reporter              AnalyticsReporter
-	startTime             time.Time
 	conf                  *config.Config
 	notifiers             []notifier.Notifier
+	eventRecorder         EventRecorder
 	recommFactory         RecommendationFactory
+	podLogsFetcher        PodLogsFetcher
+	workloadResolver      *workload.Resolver
 	filterEngine          filterengine.FilterEngine
 	informersResyncPeriod time.Duration
 	sourcesRouter         *sources.Router
 
 	dynamicCli dynamic.Interface
 
-	mapper                     meta.RESTMapper
-	dynamicKubeInformerFactory dynamicinformer.DynamicSharedInformerFactory
+	mapper meta.RESTMapper
+
+	confMutex sync.RWMutex
+
+	// informersMutex guards the informers, which are started and stopped on configuration reload.
+	informersMutex sync.Mutex
+	informersCtx   context.Context
+	informers      map[string]*informerRun
+
+	// startTimesMutex guards the informers start times, which are read by event handlers.
+	startTimesMutex sync.RWMutex
+	// startTimes holds the start time of informers indexed by resources.
+	// Objects created before a given informer started are not reported, so the informers started during the configuration reload don't announce existing objects.
+	startTimes map[string]time.Time
+}
+
+type informerRun struct {
+	informer cache.SharedIndexInformer
+	cancel   context.CancelFunc
 }
 
 // New create a new Controller instance.
 func New(log logrus.FieldLogger,
 	conf *config.Config,
 	notifiers []notifier.Notifier,
+	eventRecorder EventRecorder,
 	recommFactory RecommendationFactory,
+	podLogsFetcher PodLogsFetcher,
 	filterEngine filterengine.FilterEngine,
 	dynamicCli dynamic.Interface,
 	mapper meta.RESTMapper,
+	workloadResolver *workload.Resolver,
 	informersResyncPeriod time.Duration,
 	router *sources.Router,
 	reporter AnalyticsReporter,
@@ -85,33 +134,91 @@ func New(log logrus.FieldLogger,
 		log:                   log,
 		conf:                  conf,
 		notifiers:             notifiers,
+		eventRecorder:         eventRecorder,
 		recommFactory:         recommFactory,
+		podLogsFetcher:        podLogsFetcher,
+		workloadResolver:      workloadResolver,
 		filterEngine:          filterEngine,
 		dynamicCli:            dynamicCli,
 		mapper:                mapper,
 		informersResyncPeriod: informersResyncPeriod,
 		sourcesRouter:         router,
 		reporter:              reporter,
+		informers:             make(map[string]*informerRun),
+		startTimes:            make(map[string]time.Time),
 	}
 }
 
 // Start creates new informer controllers to watch k8s resources
 func (c *Controller) Start(ctx context.Context) error {
 	c.log.Info("Starting controller...")
-	c.dynamicKubeInformerFactory = dynamicinformer.NewDynamicSharedInformerFactory(c.dynamicCli, c.informersResyncPeriod)
 
+	err := c.registerInformers(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.log.Info("Sending welcome message...")
+	err = notifier.SendPlaintextMessage(ctx, c.notifiers, fmt.Sprintf(controllerStartMsg, c.getConfig().Settings.ClusterName))
+	if err != nil {
+		return fmt.Errorf("while sending first message: %w", err)
+	}
+
+	c.informersMutex.Lock()
+	c.informersCtx = ctx
+	c.startInformers()
+	c.informersMutex.Unlock()
+
+	<-ctx.Done()
+
+	c.log.Info("Shutdown requested. Sending final message...")
+	finalMsgCtx, cancelFn := context.WithTimeout(context.Background(), finalMessageTimeout)
+	defer cancelFn()
+	err = notifier.SendPlaintextMessage(finalMsgCtx, c.notifiers, fmt.Sprintf(controllerStopMsg, c.getConfig().Settings.ClusterName))
+	if err != nil {
+		return fmt.Errorf("while sending final message: %w", err)
+	}
+
+	return nil
+}
+
+// Reload applies a given configuration of sources without restart.
+// Informers are started for new resources and stopped for the ones which are not watched anymore.
+func (c *Controller) Reload(cfg *config.Config) (ReloadSummary, error) {
+	c.informersMutex.Lock()
+	defer c.informersMutex.Unlock()
+
+	if c.informersCtx == nil {
+		return ReloadSummary{}, errors.New("controller is not started yet")
+	}
+
+	c.setConfig(cfg)
+	c.sourcesRouter.Rebuild(cfg)
+	if err := c.registerInformers(c.informersCtx); err != nil {
+		return ReloadSummary{}, err
+	}
+	c.sourcesRouter.UnregisterUnused()
+
+	summary := ReloadSummary{
+		StoppedResources: c.stopUnusedInformers(),
+		StartedResources: c.startInformers(),
+	}
+	c.log.Infof("Configuration reloaded. Started informers: %v, stopped informers: %v", summary.StartedResources, summary.StoppedResources)
+	return summary, nil
+}
+
+// ReloadSummary describes the changes applied during the configuration reload.
+type ReloadSummary struct {
+	StartedResources []string
+	StoppedResources []string
+}
+
+func (c *Controller) registerInformers(ctx context.Context) error {
 	err := c.sourcesRouter.RegisterInformers([]config.EventType{
 		config.CreateEvent,
 		config.UpdateEvent,
 		config.DeleteEvent,
-	}, func(resource string) (cache.SharedIndexInformer, error) {
-		gvr, err := c.parseResourceArg(resource)
-		if err != nil {
-			c.log.Infof("Unable to parse resource: %s to register with informer\n", resource)
-			return nil, err
-		}
-		return c.dynamicKubeInformerFactory.ForResource(gvr).Informer(), nil
-	})
+	}, c.informerForResource)
 	if err != nil {
 		c.log.WithFields(logrus.Fields{
 			"events": []config.EventType{
@@ -124,30 +231,26 @@ func (c *Controller) Start(ctx context.Context) error {
 		return err
 	}
 
-	err = c.sourcesRouter.MapWithEventsInformer(
-		config.ErrorEvent,
-		config.WarningEvent,
-		func(resource string) (cache.SharedIndexInformer, error) {
-			gvr, err := c.parseResourceArg(resource)
-			if err != nil {
-				c.log.Infof("Unable to parse resource: %s to register with informer\n", resource)
-				return nil, err
-			}
-			return c.dynamicKubeInformerFactory.ForResource(gvr).Informer(), nil
-		})
-	if err != nil {
-		c.log.WithFields(logrus.Fields{
-			"srcEvent": config.ErrorEvent,
-			"dstEvent": config.WarningEvent,
-			"error":    err.Error(),
-		}).Errorf("Could not map event with events informer.")
-		return err
+	for _, mapping := range mappedEvents {
+		err = c.sourcesRouter.MapWithEventsInformer(
+			mapping.srcEvent,
+			mapping.dstEvent,
+			c.informerForResource,
+		)
+		if err != nil {
+			c.log.WithFields(logrus.Fields{
+				"srcEvent": mapping.srcEvent,
+				"dstEvent": mapping.dstEvent,
+				"error":    err.Error(),
+			}).Errorf("Could not map event with events informer.")
+			return err
+		}
 	}
 
 	c.sourcesRouter.HandleEvent(
 		ctx,
 		config.CreateEvent,
-		func(ctx context.Context, resource string, sources []string, _ []string) func(obj interface{}) {
+		func(ctx context.Context, resource string, sources []string, _ []string, _ interface{}) func(obj interface{}) {
 			return func(obj interface{}) {
 				c.log.WithFields(logrus.Fields{
 					"resource": resource,
@@ -155,14 +258,14 @@ func (c *Controller) Start(ctx context.Context) error {
 					"event":    config.CreateEvent,
 					"object":   obj,
 				}).Debugf("Processing K8s resource...")
-				c.sendEvent(ctx, obj, resource, config.CreateEvent, sources, nil)
+				c.sendEvent(ctx, obj, nil, resource, config.CreateEvent, sources, nil)
 			}
 		})
 
 	c.sourcesRouter.HandleEvent(
 		ctx,
 		config.DeleteEvent,
-		func(ctx context.Context, resource string, sources []string, _ []string) func(obj interface{}) {
+		func(ctx context.Context, resource string, sources []string, _ []string, _ interface{}) func(obj interface{}) {
 			return func(obj interface{}) {
 				c.log.WithFields(logrus.Fields{
 					"resource": resource,
@@ -170,14 +273,14 @@ func (c *Controller) Start(ctx context.Context) error {
 					"event":    config.DeleteEvent,
 					"object":   obj,
 				}).Debugf("Processing K8s resource...")
-				c.sendEvent(ctx, obj, resource, config.DeleteEvent, sources, nil)
+				c.sendEvent(ctx, obj, nil, resource, config.DeleteEvent, sources, nil)
 			}
 		})
 
 	c.sourcesRouter.HandleEvent(
 		ctx,
 		config.UpdateEvent,
-		func(ctx context.Context, resource string, sources []string, updateDiffs []string) func(obj interface{}) {
+		func(ctx context.Context, resource string, sources []string, updateDiffs []string, oldObj interface{}) func(obj interface{}) {
 			return func(obj interface{}) {
 				c.log.WithFields(logrus.Fields{
 					"resource": resource,
@@ -185,68 +288,157 @@ func (c *Controller) Start(ctx context.Context) error {
 					"event":    config.UpdateEvent,
 					"object":   obj,
 				}).Debugf("Processing K8s resource...")
-				c.sendEvent(ctx, obj, resource, config.UpdateEvent, sources, updateDiffs)
+				c.sendEvent(ctx, obj, oldObj, resource, config.UpdateEvent, sources, updateDiffs)
 			}
 		})
 
-	c.sourcesRouter.HandleMappedEvent(
+	for _, mapping := range mappedEvents {
+		eventType := mapping.srcEvent
+		c.sourcesRouter.HandleMappedEvent(
+			ctx,
+			eventType,
+			func(ctx context.Context, resource string, sources []string, _ []string, _ interface{}) func(obj interface{}) {
+				return func(obj interface{}) {
+					// mapped events are observed by the Kubernetes Events informer
+					event, ok := c.newEvent(ctx, obj, resource, c.sourcesRouter.EventsResource(), eventType)
+					if !ok {
+						return
+					}
+					c.processEvent(ctx, obj, event, sources)
+				}
+			})
+	}
+
+	err = c.sourcesRouter.HandleConditionEvent(
 		ctx,
-		config.ErrorEvent,
-		func(ctx context.Context, resource string, sources []string, _ []string) func(obj interface{}) {
+		c.informerForResource,
+		func(ctx context.Context, resource string, sources []string, transition sources.ConditionTransition) func(obj interface{}) {
 			return func(obj interface{}) {
-				c.sendEvent(ctx, obj, resource, config.ErrorEvent, sources, nil)
+				c.log.WithFields(logrus.Fields{
+					"resource":  resource,
+					"sources":   sources,
+					"condition": transition.Type,
+					"status":    transition.Status,
+				}).Debugf("Processing K8s resource condition...")
+				c.sendConditionEvent(ctx, obj, resource, sources, transition)
 			}
 		})
-
-	c.log.Info("Sending welcome message...")
-	err = notifier.SendPlaintextMessage(ctx, c.notifiers, fmt.Sprintf(controllerStartMsg, c.conf.Settings.ClusterName))
 	if err != nil {
-		return fmt.Errorf("while sending first message: %w", err)
+		c.log.WithFields(logrus.Fields{
+			"event": config.ErrorEvent,
+			"error": err.Error(),
+		}).Errorf("Could not register informer for status conditions.")
+		return err
 	}
 
-	c.startTime = time.Now()
-
-	stopCh := ctx.Done()
-	c.dynamicKubeInformerFactory.Start(stopCh)
+	return nil
+}
 
-	<-stopCh
+// informerForResource returns the informer for a given resource. The informer is created if it doesn't exist yet.
+func (c *Controller) informerForResource(resource string) (cache.SharedIndexInformer, error) {
+	if run, exists := c.informers[resource]; exists {
+		return run.informer, nil
+	}
 
-	c.log.Info("Shutdown requested. Sending final message...")
-	finalMsgCtx, cancelFn := context.WithTimeout(context.Background(), finalMessageTimeout)
-	defer cancelFn()
-	err = notifier.SendPlaintextMessage(finalMsgCtx, c.notifiers, fmt.Sprintf(controllerStopMsg, c.conf.Settings.ClusterName))
+	gvr, err := c.parseResourceArg(resource)
 	if err != nil {
-		return fmt.Errorf("while sending final message: %w", err)
+		c.log.Infof("Unable to parse resource: %s to register with informer\n", resource)
+		return nil, err
 	}
 
-	return nil
+	informer := dynamicinformer.NewFilteredDynamicInformer(
+		c.dynamicCli,
+		gvr,
+		metaV1.NamespaceAll,
+		c.informersResyncPeriod,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		nil,
+	).Informer()
+	c.informers[resource] = &informerRun{informer: informer}
+	return informer, nil
 }
 
-func (c *Controller) sendEvent(ctx context.Context, obj interface{}, resource string, eventType config.EventType, sources []string, updateDiffs []string) {
-	// Filter namespaces
-	objectMeta, err := utils.GetObjectMetaData(ctx, c.dynamicCli, c.mapper, obj)
-	if err != nil {
-		c.log.Errorf("while getting object metadata: %s", err.Error())
-		return
+// startInformers runs the informers which are not started yet, and returns their resources.
+func (c *Controller) startInformers() []string {
+	var started []string
+	for resource, run := range c.informers {
+		if run.cancel != nil {
+			continue
+		}
+
+		ctx, cancel := context.WithCancel(c.informersCtx)
+		run.cancel = cancel
+		c.setStartTime(resource, time.Now())
+		go run.informer.Run(ctx.Done())
+		started = append(started, resource)
 	}
 
-	c.log.Debugf("Processing %s to %s/%v in %s namespace", eventType, resource, objectMeta.Name, objectMeta.Namespace)
+	sort.Strings(started)
+	return started
+}
 
-	// Create new event object
-	event, err := events.New(objectMeta, obj, eventType, resource, c.conf.Settings.ClusterName)
-	if err != nil {
-		c.log.Errorf("while creating new event: %w", err)
-		return
+// stopUnusedInformers stops the informers which are not registered in the sources router anymore, and returns their resources.
+func (c *Controller) stopUnusedInformers() []string {
+	used := map[string]struct{}{}
+	for _, resource := range c.sourcesRouter.RegisteredResources() {
+		used[resource] = struct{}{}
 	}
 
-	// Skip older events
-	if !event.TimeStamp.IsZero() {
-		if event.TimeStamp.Before(c.startTime) {
-			c.log.Debug("Skipping older events")
-			return
+	var stopped []string
+	for resource, run := range c.informers {
+		if _, ok := used[resource]; ok {
+			continue
+		}
+
+		if run.cancel != nil {
+			run.cancel()
 		}
+		delete(c.informers, resource)
+		c.setStartTime(resource, time.Time{})
+		stopped = append(stopped, resource)
 	}
 
+	sort.Strings(stopped)
+	return stopped
+}
+
+// setStartTime sets the start time of the informer for a given resource. Zero time removes the entry.
+func (c *Controller) setStartTime(resource string, startTime time.Time) {
+	c.startTimesMutex.Lock()
+	defer c.startTimesMutex.Unlock()
+
+	if startTime.IsZero() {
+		delete(c.startTimes, resource)
+		return
+	}
+	c.startTimes[resource] = startTime
+}
+
+func (c *Controller) getStartTime(resource string) time.Time {
+	c.startTimesMutex.RLock()
+	defer c.startTimesMutex.RUnlock()
+	return c.startTimes[resource]
+}
+
+func (c *Controller) getConfig() *config.Config {
+	c.confMutex.RLock()
+	defer c.confMutex.RUnlock()
+	return c.conf
+}
+
+func (c *Controller) setConfig(cfg *config.Config) {
+	c.confMutex.Lock()
+	defer c.confMutex.Unlock()
+	c.conf = cfg
+}
+
+func (c *Controller) sendEvent(ctx context.Context, obj, oldObj interface{}, resource string, eventType config.EventType, sources []string, updateDiffs []string) {
+	event, ok := c.newEvent(ctx, obj, resource, resource, eventType)
+	if !ok {
+		return
+	}
+	event.OldObject = oldObj
+
 	// Check for significant Update Events in objects
 	if eventType == config.UpdateEvent {
 		switch {
@@ -255,12 +447,63 @@ func (c *Controller) sendEvent(ctx context.Context, obj interface{}, resource st
 			c.log.Debug("skipping least significant Update event")
 			event.Skip = true
 		case len(updateDiffs) > 0:
-			event.Messages = append(event.Messages, updateDiffs...)
+			event.Diff = joinUpdateDiffs(updateDiffs)
 		default:
 			// send event with no diff message
 		}
 	}
 
+	c.processEvent(ctx, obj, event, sources)
+}
+
+// sendConditionEvent sends the error or resolved event for a given status condition transition.
+func (c *Controller) sendConditionEvent(ctx context.Context, obj interface{}, resource string, sources []string, transition sources.ConditionTransition) {
+	event, ok := c.newEvent(ctx, obj, resource, resource, transition.EventType())
+	if !ok {
+		return
+	}
+
+	event.Reason = transition.Reason
+	event.Messages = append(event.Messages, fmt.Sprintf("Condition %s changed to %s", transition.Type, transition.Status))
+	if transition.Message != "" {
+		event.Messages = append(event.Messages, transition.Message)
+	}
+
+	c.processEvent(ctx, obj, event, sources)
+}
+
+// newEvent creates a new event for a given object. It returns false if the event should be skipped.
+// The informerResource identifies the informer which observed the object, as mapped events are observed by the Kubernetes Events informer.
+func (c *Controller) newEvent(ctx context.Context, obj interface{}, resource, informerResource string, eventType config.EventType) (events.Event, bool) {
+	// Filter namespaces
+	objectMeta, err := utils.GetObjectMetaData(ctx, c.dynamicCli, c.mapper, obj)
+	if err != nil {
+		c.log.Errorf("while getting object metadata: %s", err.Error())
+		return events.Event{}, false
+	}
+
+	c.log.Debugf("Processing %s to %s/%v in %s namespace", eventType, resource, objectMeta.Name, objectMeta.Namespace)
+
+	// Create new event object
+	event, err := events.New(objectMeta, obj, eventType, resource, c.getConfig().Settings.ClusterName)
+	if err != nil {
+		c.log.Errorf("while creating new event: %w", err)
+		return events.Event{}, false
+	}
+
+	// Skip older events
+	if !event.TimeStamp.IsZero() {
+		if event.TimeStamp.Before(c.getStartTime(informerResource)) {
+			c.log.Debug("Skipping older events")
+			return events.Event{}, false
+		}
+	}
+
+	return event, true
+}
+
+// processEvent runs filters, recommendations and enrichments for a given event, and sends it over notifiers.
+func (c *Controller) processEvent(ctx context.Context, obj interface{}, event events.Event, sources []string) {
 	// Filter events
 	event = c.filterEngine.Run(ctx, event)
 	if event.Skip {
@@ -273,17 +516,33 @@ func (c *Controller) sendEvent(ctx context.Context, obj interface{}, resource st
 		return
 	}
 
-	recRunner, recCfg := c.recommFactory.NewForSources(c.conf.Sources, sources)
+	owner, err := c.workloadResolver.TopLevelOwner(ctx, obj)
+	if err != nil {
+		c.log.Errorf("while resolving top-level workload: %s", err.Error())
+	}
+	event.WorkloadKind, event.WorkloadName = owner.Kind, owner.Name
+
+	recRunner, recCfg := c.recommFactory.NewForSources(c.getConfig().Sources, sources)
 	err = recRunner.Do(ctx, &event)
 	if err != nil {
 		c.log.Errorf("while running recommendations: %w", err)
 	}
 
-	if recommendation.ShouldIgnoreEvent(recCfg, c.conf.Sources, sources, event) {
+	if recommendation.ShouldIgnoreEvent(recCfg, c.getConfig().Sources, sources, event) {
 		c.log.Debugf("Skipping event as it is related to recommendation informers and doesn't have any recommendations: %#v", event)
 		return
 	}
 
+	logsCfg, logsSources := sourcePodLogs(c.getConfig().Sources, sources)
+	err = c.podLogsFetcher.AttachToEvent(ctx, &event, logsCfg)
+	if err != nil {
+		c.log.Errorf("while attaching Pod logs: %s", err.Error())
+	}
+	event.LogsSources = logsSources
+
+	event.SourceTemplates = sourceEventTemplates(c.getConfig().Sources, sources)
+	c.eventRecorder.RecordEvent(event, sources)
+
 	// Send event over notifiers
 	anonymousEvent := analytics.AnonymizedEventDetailsFrom(event)
 	for _, n := range c.notifiers {
@@ -333,3 +592,51 @@ func (c *Controller) strToGVR(arg string) (schema.GroupVersionResource, error) {
 		return schema.GroupVersionResource{}, fmt.Errorf("invalid string: expected 2 or 3 parts when split by %q", separator)
 	}
 }
+
+// joinUpdateDiffs joins diffs reported by many sources, skipping the duplicated ones.
+func joinUpdateDiffs(diffs []string) string {
+	seen := map[string]struct{}{}
+	var out strings.Builder
+	for _, diff := range diffs {
+		if _, found := seen[diff]; found {
+			continue
+		}
+		seen[diff] = struct{}{}
+		out.WriteString(diff)
+	}
+	return strings.TrimSpace(out.String())
+}
+
+// sourceEventTemplates returns the event templates of the sources which define it, indexed by the source name.
+// The template is resolved per channel, as channels may be bound to different sources.
+func sourceEventTemplates(srcs map[string]config.Sources, sourceNames []string) map[string]config.EventTemplate {
+	out := map[string]config.EventTemplate{}
+	for _, name := range sourceNames {
+		if tpl := srcs[name].EventTemplate; !tpl.IsEmpty() {
+			out[name] = tpl
+		}
+	}
+
+	return out
+}
+
+// sourcePodLogs returns the Pod logs configuration of the first event source which enables it, together with all sources which enable it.
+// The logs are sent only to channels bound to such sources.
+func sourcePodLogs(srcs map[string]config.Sources, sourceNames []string) (config.PodLogs, []string) {
+	var (
+		out     config.PodLogs
+		enabled []string
+	)
+	for _, name := range sourceNames {
+		cfg := srcs[name].Kubernetes.Logs
+		if !cfg.Enabled {
+			continue
+		}
+		if len(enabled) == 0 {
+			out = cfg
+		}
+		enabled = append(enabled, name)
+	}
+
+	return out, enabled
+}
@@ -1,11 +1,19 @@
 package controller
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
 )
 
 // TODO: Refactor these tests as a part of https://github.com/kubeshop/botkube/issues/589
@@ -71,3 +79,60 @@ func TestController_strToGVR(t *testing.T) {
 		})
 	}
 }
+
+func TestController_NewEventSkipsObjectsCreatedBeforeInformerStart(t *testing.T) {
+	// given
+	reloadTime := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	c := &Controller{
+		log:        logrus.New(),
+		conf:       &config.Config{},
+		startTimes: map[string]time.Time{},
+	}
+	// informer started during the configuration reload
+	c.setStartTime("v1/pods", reloadTime)
+
+	fixPod := func(createdAt time.Time) *unstructured.Unstructured {
+		pod := &unstructured.Unstructured{}
+		pod.SetAPIVersion("v1")
+		pod.SetKind("Pod")
+		pod.SetName("api")
+		pod.SetNamespace("team-a")
+		pod.SetCreationTimestamp(metaV1.NewTime(createdAt))
+		return pod
+	}
+
+	// when
+	_, existingReported := c.newEvent(context.Background(), fixPod(reloadTime.Add(-time.Hour)), "v1/pods", "v1/pods", config.CreateEvent)
+	_, newReported := c.newEvent(context.Background(), fixPod(reloadTime.Add(time.Second)), "v1/pods", "v1/pods", config.CreateEvent)
+
+	// then
+	assert.False(t, existingReported, "objects existing before the informer started shouldn't be reported as created")
+	assert.True(t, newReported)
+}
+
+func TestSourcePodLogs(t *testing.T) {
+	// given
+	srcs := map[string]config.Sources{
+		"k8s-events": {},
+		"k8s-err-logs": {
+			Kubernetes: config.KubernetesSource{Logs: config.PodLogs{Enabled: true, TailLines: 10}},
+		},
+		"k8s-err-more-logs": {
+			Kubernetes: config.KubernetesSource{Logs: config.PodLogs{Enabled: true, TailLines: 50}},
+		},
+	}
+
+	// when
+	cfg, enabled := sourcePodLogs(srcs, []string{"k8s-events", "k8s-err-logs", "k8s-err-more-logs"})
+
+	// then
+	assert.Equal(t, config.PodLogs{Enabled: true, TailLines: 10}, cfg)
+	assert.Equal(t, []string{"k8s-err-logs", "k8s-err-more-logs"}, enabled)
+
+	// when
+	event := events.Event{Logs: "fake logs", LogsSources: enabled}
+
+	// then
+	assert.Empty(t, event.ForSources([]string{"k8s-events"}).Logs)
+	assert.Equal(t, "fake logs", event.ForSources([]string{"k8s-events", "k8s-err-more-logs"}).Logs)
+}
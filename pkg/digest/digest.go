@@ -0,0 +1,202 @@
+package digest
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+const (
+	// maxTrackedKeys limits the number of distinct keys counted in a single breakdown of an aggregate.
+	// Once the limit is reached, events with new keys are counted only in the total.
+	maxTrackedKeys = 500
+
+	// maxRecommendations limits the number of distinct recommendations kept in a single aggregate.
+	maxRecommendations = 20
+)
+
+// KindReason groups events by the object kind and event reason.
+type KindReason struct {
+	Kind   string
+	Reason string
+}
+
+// Object identifies a Kubernetes object.
+type Object struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+// Ref returns the object reference in the `namespace/name` format. Cluster-scoped objects are referenced only by name.
+func (o Object) Ref() string {
+	return objectRef(o.Namespace, o.Name)
+}
+
+// NamespaceLevel groups events by the object namespace and event level.
+type NamespaceLevel struct {
+	Namespace string
+	Level     config.Level
+}
+
+// Count holds a number of events for a given key.
+type Count[K comparable] struct {
+	Key   K
+	Count int
+}
+
+// Aggregate holds counters of events recorded since a given time.
+// It doesn't keep the events themselves, so its size is bounded regardless of the number of recorded events.
+type Aggregate struct {
+	Since   time.Time
+	Cluster string
+	Total   int
+
+	byKindReason     map[KindReason]int
+	byObject         map[Object]int
+	byNamespaceLevel map[NamespaceLevel]int
+	recommendations  []string
+}
+
+// New returns a new Aggregate instance.
+func New(since time.Time) *Aggregate {
+	return &Aggregate{
+		Since:            since,
+		byKindReason:     map[KindReason]int{},
+		byObject:         map[Object]int{},
+		byNamespaceLevel: map[NamespaceLevel]int{},
+	}
+}
+
+// Add records a given event.
+func (a *Aggregate) Add(event events.Event) {
+	reason := event.Reason
+	if reason == "" {
+		reason = string(event.Type)
+	}
+
+	a.Total++
+	if a.Cluster == "" {
+		a.Cluster = event.Cluster
+	}
+	increment(a.byKindReason, KindReason{Kind: event.Kind, Reason: reason}, 1)
+	increment(a.byObject, Object{Kind: event.Kind, Namespace: event.Namespace, Name: event.Name}, 1)
+	increment(a.byNamespaceLevel, NamespaceLevel{Namespace: event.Namespace, Level: event.Level}, 1)
+
+	for _, recomm := range event.Recommendations {
+		a.addRecommendation(fmt.Sprintf("%s %s: %s", event.Kind, objectRef(event.Namespace, event.Name), recomm))
+	}
+}
+
+// Merge adds counters of a given aggregate. The earlier start time of both aggregates is kept.
+func (a *Aggregate) Merge(other *Aggregate) {
+	if other == nil {
+		return
+	}
+
+	a.Total += other.Total
+	if a.Cluster == "" {
+		a.Cluster = other.Cluster
+	}
+	if !other.Since.IsZero() && other.Since.Before(a.Since) {
+		a.Since = other.Since
+	}
+
+	for key, count := range other.byKindReason {
+		increment(a.byKindReason, key, count)
+	}
+	for key, count := range other.byObject {
+		increment(a.byObject, key, count)
+	}
+	for key, count := range other.byNamespaceLevel {
+		increment(a.byNamespaceLevel, key, count)
+	}
+	for _, recomm := range other.recommendations {
+		a.addRecommendation(recomm)
+	}
+}
+
+// KindReasons returns event counts grouped by kind and reason, the most frequent first.
+func (a *Aggregate) KindReasons() []Count[KindReason] {
+	return sortedCounts(a.byKindReason, func(i, j KindReason) bool {
+		if i.Kind != j.Kind {
+			return i.Kind < j.Kind
+		}
+		return i.Reason < j.Reason
+	})
+}
+
+// TopObjects returns up to a given number of objects with the most events.
+func (a *Aggregate) TopObjects(limit int) []Count[Object] {
+	out := sortedCounts(a.byObject, func(i, j Object) bool {
+		return fmt.Sprintf("%s/%s/%s", i.Kind, i.Namespace, i.Name) < fmt.Sprintf("%s/%s/%s", j.Kind, j.Namespace, j.Name)
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// NamespaceLevels returns event counts grouped by namespace and level, sorted by namespace and level.
+func (a *Aggregate) NamespaceLevels() []Count[NamespaceLevel] {
+	out := make([]Count[NamespaceLevel], 0, len(a.byNamespaceLevel))
+	for key, count := range a.byNamespaceLevel {
+		out = append(out, Count[NamespaceLevel]{Key: key, Count: count})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Key.Namespace != out[j].Key.Namespace {
+			return out[i].Key.Namespace < out[j].Key.Namespace
+		}
+		return out[i].Key.Level < out[j].Key.Level
+	})
+	return out
+}
+
+// Recommendations returns distinct recommendations raised for recorded events.
+func (a *Aggregate) Recommendations() []string {
+	return a.recommendations
+}
+
+func (a *Aggregate) addRecommendation(item string) {
+	if len(a.recommendations) >= maxRecommendations {
+		return
+	}
+	for _, existing := range a.recommendations {
+		if existing == item {
+			return
+		}
+	}
+	a.recommendations = append(a.recommendations, item)
+}
+
+func increment[K comparable](counts map[K]int, key K, count int) {
+	if _, tracked := counts[key]; !tracked && len(counts) >= maxTrackedKeys {
+		return
+	}
+	counts[key] += count
+}
+
+// sortedCounts returns counts sorted in descending order. Equal counts are sorted with a given function.
+func sortedCounts[K comparable](counts map[K]int, less func(i, j K) bool) []Count[K] {
+	out := make([]Count[K], 0, len(counts))
+	for key, count := range counts {
+		out = append(out, Count[K]{Key: key, Count: count})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Count != out[j].Count {
+			return out[i].Count > out[j].Count
+		}
+		return less(out[i].Key, out[j].Key)
+	})
+	return out
+}
+
+func objectRef(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
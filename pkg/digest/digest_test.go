@@ -0,0 +1,58 @@
+package digest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestAggregate_AddAndMerge(t *testing.T) {
+	// given
+	since := time.Date(2022, 9, 1, 9, 0, 0, 0, time.UTC)
+	first := New(since)
+	second := New(since.Add(-time.Hour))
+
+	// when
+	first.Add(events.Event{TypeMeta: metaV1.TypeMeta{Kind: "Pod"}, Name: "nginx", Namespace: "default", Reason: "BackOff", Level: config.Error, Recommendations: []string{"Check logs"}})
+	first.Add(events.Event{TypeMeta: metaV1.TypeMeta{Kind: "Pod"}, Name: "nginx", Namespace: "default", Reason: "BackOff", Level: config.Error, Recommendations: []string{"Check logs"}})
+	second.Add(events.Event{TypeMeta: metaV1.TypeMeta{Kind: "Node"}, Name: "node-1", Type: config.UpdateEvent, Level: config.Info})
+	first.Merge(second)
+
+	// then
+	assert.Equal(t, 3, first.Total)
+	assert.Equal(t, since.Add(-time.Hour), first.Since)
+	assert.Equal(t, []Count[KindReason]{
+		{Key: KindReason{Kind: "Pod", Reason: "BackOff"}, Count: 2},
+		{Key: KindReason{Kind: "Node", Reason: "update"}, Count: 1},
+	}, first.KindReasons())
+	assert.Equal(t, []Count[Object]{
+		{Key: Object{Kind: "Pod", Namespace: "default", Name: "nginx"}, Count: 2},
+	}, first.TopObjects(1))
+	assert.Equal(t, []Count[NamespaceLevel]{
+		{Key: NamespaceLevel{Namespace: "", Level: config.Info}, Count: 1},
+		{Key: NamespaceLevel{Namespace: "default", Level: config.Error}, Count: 2},
+	}, first.NamespaceLevels())
+	assert.Equal(t, []string{"Pod default/nginx: Check logs"}, first.Recommendations())
+}
+
+func TestAggregate_IsBounded(t *testing.T) {
+	// given
+	aggregate := New(time.Now())
+
+	// when
+	for i := 0; i < maxTrackedKeys+100; i++ {
+		name := fmt.Sprintf("pod-%d", i)
+		aggregate.Add(events.Event{TypeMeta: metaV1.TypeMeta{Kind: "Pod"}, Name: name, Namespace: "default", Level: config.Error, Recommendations: []string{name}})
+	}
+
+	// then
+	assert.Equal(t, maxTrackedKeys+100, aggregate.Total)
+	assert.Len(t, aggregate.TopObjects(maxTrackedKeys+100), maxTrackedKeys)
+	assert.Len(t, aggregate.Recommendations(), maxRecommendations)
+}
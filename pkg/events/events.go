@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/sliceutil"
 	"github.com/kubeshop/botkube/pkg/utils"
 )
 
@@ -34,9 +35,46 @@ type Event struct {
 	Skip      bool `json:",omitempty"`
 	Resource  string
 	Object    interface{} `json:"-"`
+	// OldObject is the previous state of the object for update events.
+	OldObject interface{} `json:"-"`
+	// Template is the event template of the event source. It can be overridden per channel.
+	// Use ForSources to resolve it for sources bound to a given channel.
+	Template config.EventTemplate `json:"-"`
+	// SourceTemplates contains the event templates of the event sources, indexed by the source name.
+	SourceTemplates map[string]config.EventTemplate `json:"-"`
 
 	Recommendations []string
 	Warnings        []string
+	// WorkloadKind and WorkloadName identify the top-level workload which controls the object, e.g. Deployment.
+	WorkloadKind string `json:",omitempty"`
+	WorkloadName string `json:",omitempty"`
+	// RelatedKind and RelatedName identify the secondary object of the Kubernetes Event, if the Event has any.
+	RelatedKind string `json:",omitempty"`
+	RelatedName string `json:",omitempty"`
+	// Diff contains the changed fields of an updated object. It is rendered separately from messages, as it can be long.
+	Diff string `json:",omitempty"`
+	// Logs contains the most recent logs of the failing container attached to Pod error events.
+	Logs string `json:",omitempty"`
+	// LogsSources contains the event sources which enable attaching the Pod logs.
+	LogsSources []string `json:"-"`
+}
+
+// ForSources returns the event for a channel bound to given sources.
+// The event template is taken from the first given source which defines it,
+// and the Pod logs are kept only if any of given sources enables them.
+func (e Event) ForSources(sources []string) Event {
+	e.Template = config.EventTemplate{}
+	for _, name := range sources {
+		if tpl, ok := e.SourceTemplates[name]; ok {
+			e.Template = tpl
+			break
+		}
+	}
+
+	if !sliceutil.Intersect(e.LogsSources, sources) {
+		e.Logs = ""
+	}
+	return e
 }
 
 // HasRecommendationsOrWarnings returns true if event has recommendations or warnings.
@@ -44,13 +82,33 @@ func (e *Event) HasRecommendationsOrWarnings() bool {
 	return len(e.Recommendations) > 0 || len(e.Warnings) > 0
 }
 
+// Workload returns the top-level workload which controls the event object in the `Kind/name` format.
+// If the object isn't controlled by any workload, it returns empty string.
+func (e *Event) Workload() string {
+	if e.WorkloadKind == "" || e.WorkloadName == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", e.WorkloadKind, e.WorkloadName)
+}
+
+// Related returns the secondary object of the Kubernetes Event in the `Kind/name` format.
+// If the event doesn't have any related object, it returns empty string.
+func (e *Event) Related() string {
+	if e.RelatedKind == "" || e.RelatedName == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", e.RelatedKind, e.RelatedName)
+}
+
 // LevelMap is a map of event type to Level
 var LevelMap = map[config.EventType]config.Level{
-	config.CreateEvent:  config.Info,
-	config.UpdateEvent:  config.Warn,
-	config.DeleteEvent:  config.Critical,
-	config.ErrorEvent:   config.Error,
-	config.WarningEvent: config.Error,
+	config.CreateEvent:   config.Info,
+	config.UpdateEvent:   config.Warn,
+	config.DeleteEvent:   config.Critical,
+	config.ErrorEvent:    config.Error,
+	config.WarningEvent:  config.Error,
+	config.NormalEvent:   config.Info,
+	config.ResolvedEvent: config.Info,
 }
 
 // New extract required details from k8s object and returns new Event object
@@ -84,7 +142,7 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 	}
 
 	switch eventType {
-	case config.ErrorEvent, config.InfoEvent:
+	case config.ErrorEvent, config.WarningEvent, config.NormalEvent, config.InfoEvent, config.ResolvedEvent:
 		event.Title = fmt.Sprintf("%s %s", resource, eventType.String())
 	default:
 		// Events like create, update, delete comes with an extra 'd' at the end
@@ -92,14 +150,12 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 	}
 
 	if objectTypeMeta.Kind == "Event" {
-		var eventObj coreV1.Event
-
 		unstrObj, ok := object.(*unstructured.Unstructured)
 		if !ok {
 			return Event{}, fmt.Errorf("cannot convert type %T into *unstructured.Unstructured", object)
 		}
 
-		err := utils.TransformIntoTypedObject(unstrObj, &eventObj)
+		eventObj, err := utils.TransformIntoCoreEvent(unstrObj)
 		if err != nil {
 			return Event{}, fmt.Errorf("while transforming object type %T into type: %T: %w", object, eventObj, err)
 		}
@@ -113,15 +169,32 @@ func New(objectMeta metaV1.ObjectMeta, object interface{}, eventType config.Even
 		event.Name = eventObj.InvolvedObject.Name
 		event.Namespace = eventObj.InvolvedObject.Namespace
 		event.Level = LevelMap[config.EventType(strings.ToLower(eventObj.Type))]
-		event.Count = eventObj.Count
 		event.Action = eventObj.Action
-		event.TimeStamp = eventObj.LastTimestamp.Time
-		// Compatible with events.k8s.io/v1
-		if eventObj.LastTimestamp.IsZero() && eventObj.Series != nil {
-			event.TimeStamp = eventObj.Series.LastObservedTime.Time
-			event.Count = eventObj.Series.Count
+		event.TimeStamp, event.Count = occurrence(eventObj)
+		if eventObj.Related != nil {
+			event.RelatedKind = eventObj.Related.Kind
+			event.RelatedName = eventObj.Related.Name
 		}
 	}
 
 	return event, nil
 }
+
+// occurrence returns the time of the last occurrence of a given Kubernetes Event and the number of its occurrences.
+// Events created with the events.k8s.io/v1 API don't set the deprecated timestamp and count fields,
+// so the series and the event time are used instead.
+func occurrence(eventObj coreV1.Event) (time.Time, int32) {
+	if eventObj.Series != nil {
+		return eventObj.Series.LastObservedTime.Time, eventObj.Series.Count
+	}
+
+	count := eventObj.Count
+	if count == 0 {
+		count = 1
+	}
+
+	if !eventObj.LastTimestamp.IsZero() {
+		return eventObj.LastTimestamp.Time, count
+	}
+	return eventObj.EventTime.Time, count
+}
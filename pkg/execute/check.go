@@ -0,0 +1,244 @@
+package execute
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/execute/kubectl"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	checkUsageMsg             = "Please specify the resource kind to check. Use: check <kind> [name] [-n namespace|-A]"
+	checkUnknownKindMsgFmt    = "Sorry, I don't know the '%s' resource kind on cluster '%s'."
+	checkNotFoundMsgFmt       = "%s '%s' not found in the '%s' Namespace on cluster '%s'."
+	checkNoObjectsMsgFmt      = "No %s found to check on cluster '%s'."
+	checkNoRecommendationsFmt = "Checked %d %s on cluster '%s'. No recommendations found."
+	checkSummaryMsgFmt        = "Checked %d %s on cluster '%s'."
+	checkVerb                 = "get"
+)
+
+// RecommendationFactory creates recommendation runners for given sources.
+type RecommendationFactory interface {
+	NewForSources(sources map[string]config.Sources, mapKeyOrder []string) (recommendation.AggregatedRunner, config.Recommendations)
+}
+
+// CheckExecutor runs the enabled recommendations against existing cluster objects.
+type CheckExecutor struct {
+	log               logrus.FieldLogger
+	analyticsReporter AnalyticsReporter
+	cfg               config.Config
+	kubectl           *Kubectl
+	kcChecker         *kubectl.Checker
+	merger            *kubectl.Merger
+	recommFactory     RecommendationFactory
+	dynamicCli        dynamic.Interface
+	mapper            meta.RESTMapper
+}
+
+// NewCheckExecutor creates a new instance of CheckExecutor.
+func NewCheckExecutor(log logrus.FieldLogger, analyticsReporter AnalyticsReporter, cfg config.Config, kc *Kubectl, kcChecker *kubectl.Checker, merger *kubectl.Merger, recommFactory RecommendationFactory, dynamicCli dynamic.Interface, mapper meta.RESTMapper) *CheckExecutor {
+	return &CheckExecutor{
+		log:               log,
+		analyticsReporter: analyticsReporter,
+		cfg:               cfg,
+		kubectl:           kc,
+		kcChecker:         kcChecker,
+		merger:            merger,
+		recommFactory:     recommFactory,
+		dynamicCli:        dynamicCli,
+		mapper:            mapper,
+	}
+}
+
+type checkArgs struct {
+	kind          string
+	name          string
+	namespace     string
+	allNamespaces bool
+}
+
+// Do executes the check command. It respects the Namespaces and resources allowed for the kubectl executors bound to a given conversation.
+func (e *CheckExecutor) Do(ctx context.Context, args []string, platform config.CommPlatformIntegration, conversation Conversation, clusterName string) (string, error) {
+	defer func() {
+		err := e.analyticsReporter.ReportCommand(platform, args[0], conversation.IsButtonClickOrigin)
+		if err != nil {
+			e.log.Errorf("while reporting check command: %s", err.Error())
+		}
+	}()
+
+	in, err := e.parseArgs(args)
+	if err != nil {
+		e.log.Debugf("while parsing check command: %s", err.Error())
+		return checkUsageMsg, nil
+	}
+
+	gvr, err := e.mapper.ResourceFor(schema.GroupVersionResource{Resource: in.kind})
+	if err != nil {
+		e.log.Debugf("while resolving resource %q: %s", in.kind, err.Error())
+		return fmt.Sprintf(checkUnknownKindMsgFmt, in.kind, clusterName), nil
+	}
+
+	namespace := in.namespace
+	switch {
+	case in.allNamespaces:
+		namespace = config.AllNamespaceIndicator
+	case namespace == "":
+		namespace = e.kubectl.findDefaultNamespace(conversation.ExecutorBindings)
+	}
+
+	if msg, allowed := e.isAllowed(conversation, namespace, gvr.Resource, clusterName); !allowed {
+		return msg, nil
+	}
+
+	listNs := namespace
+	if in.allNamespaces {
+		listNs = metaV1.NamespaceAll
+	}
+
+	objects, err := e.getObjects(ctx, gvr, listNs, in.name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Sprintf(checkNotFoundMsgFmt, gvr.Resource, in.name, namespace, clusterName), nil
+		}
+		return "", fmt.Errorf("while getting %s: %w", gvr.Resource, err)
+	}
+	if len(objects) == 0 {
+		return fmt.Sprintf(checkNoObjectsMsgFmt, gvr.Resource, clusterName), nil
+	}
+
+	return e.runRecommendations(ctx, gvr, objects, clusterName), nil
+}
+
+func (e *CheckExecutor) parseArgs(args []string) (checkArgs, error) {
+	var out checkArgs
+
+	f := pflag.NewFlagSet("check", pflag.ContinueOnError)
+	f.SetOutput(io.Discard)
+	f.StringVarP(&out.namespace, "namespace", "n", "", "Kubernetes Namespace")
+	f.BoolVarP(&out.allNamespaces, "all-namespaces", "A", false, "All Kubernetes Namespaces")
+	f.String(strings.TrimPrefix(ClusterFlag.String(), "--"), "", "Cluster name")
+	if err := f.Parse(args[1:]); err != nil {
+		return checkArgs{}, err
+	}
+
+	positional := f.Args()
+	switch len(positional) {
+	case 2:
+		out.name = positional[1]
+		fallthrough
+	case 1:
+		out.kind = strings.ToLower(positional[0])
+	default:
+		return checkArgs{}, fmt.Errorf("expected kind and optional name, got %d arguments", len(positional))
+	}
+
+	if out.allNamespaces && out.name != "" {
+		return checkArgs{}, fmt.Errorf("name cannot be used together with all Namespaces flag")
+	}
+
+	return out, nil
+}
+
+func (e *CheckExecutor) isAllowed(conversation Conversation, namespace, resource, clusterName string) (string, bool) {
+	kcConfig := e.merger.MergeForNamespace(conversation.ExecutorBindings, namespace)
+	if !conversation.IsAuthenticated && kcConfig.RestrictAccess {
+		return fmt.Sprintf(kubectlNotAuthorizedMsgFmt, clusterName), false
+	}
+
+	if !e.kcChecker.IsVerbAllowedInNs(kcConfig, checkVerb) {
+		if namespace == config.AllNamespaceIndicator {
+			return fmt.Sprintf(kubectlNotAllowedVerbInAllNsMsgFmt, checkVerb, clusterName), false
+		}
+		return fmt.Sprintf(kubectlNotAllowedVerbMsgFmt, checkVerb, namespace, clusterName), false
+	}
+
+	if !e.kcChecker.IsResourceAllowedInNs(kcConfig, resource) {
+		if namespace == config.AllNamespaceIndicator {
+			return fmt.Sprintf(kubectlNotAllowedKinInAllNsMsgFmt, resource, clusterName), false
+		}
+		return fmt.Sprintf(kubectlNotAllowedKindMsgFmt, resource, namespace, clusterName), false
+	}
+
+	return "", true
+}
+
+func (e *CheckExecutor) getObjects(ctx context.Context, gvr schema.GroupVersionResource, namespace, name string) ([]unstructured.Unstructured, error) {
+	cli := e.dynamicCli.Resource(gvr).Namespace(namespace)
+	if name != "" {
+		obj, err := cli.Get(ctx, name, metaV1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return []unstructured.Unstructured{*obj}, nil
+	}
+
+	list, err := cli.List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (e *CheckExecutor) runRecommendations(ctx context.Context, gvr schema.GroupVersionResource, objects []unstructured.Unstructured, clusterName string) string {
+	sourceNames := make([]string, 0, len(e.cfg.Sources))
+	for name := range e.cfg.Sources {
+		sourceNames = append(sourceNames, name)
+	}
+	sort.Strings(sourceNames)
+
+	recRunner, _ := e.recommFactory.NewForSources(e.cfg.Sources, sourceNames)
+
+	var warnings, infos []string
+	for i := range objects {
+		obj := &objects[i]
+		objectMeta := metaV1.ObjectMeta{
+			Name:              obj.GetName(),
+			Namespace:         obj.GetNamespace(),
+			CreationTimestamp: obj.GetCreationTimestamp(),
+		}
+
+		event, err := events.New(objectMeta, obj, config.CreateEvent, utils.GVRToString(gvr), clusterName)
+		if err != nil {
+			e.log.Errorf("while creating event for %s %q: %s", gvr.Resource, obj.GetName(), err.Error())
+			continue
+		}
+
+		if err := recRunner.Do(ctx, &event); err != nil {
+			e.log.Errorf("while running recommendations for %s %q: %s", gvr.Resource, obj.GetName(), err.Error())
+		}
+
+		warnings = append(warnings, event.Warnings...)
+		infos = append(infos, event.Recommendations...)
+	}
+
+	if len(warnings) == 0 && len(infos) == 0 {
+		return fmt.Sprintf(checkNoRecommendationsFmt, len(objects), gvr.Resource, clusterName)
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, checkSummaryMsgFmt, len(objects), gvr.Resource, clusterName)
+	if len(warnings) > 0 {
+		fmt.Fprintf(&out, "\n\nWarnings:\n- %s", strings.Join(warnings, "\n- "))
+	}
+	if len(infos) > 0 {
+		fmt.Fprintf(&out, "\n\nRecommendations:\n- %s", strings.Join(infos, "\n- "))
+	}
+
+	return out.String()
+}
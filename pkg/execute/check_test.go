@@ -0,0 +1,150 @@
+package execute
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/kubectl"
+	"github.com/kubeshop/botkube/pkg/ptr"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestCheckExecutor_Do(t *testing.T) {
+	// given
+	kubectlCfg := config.Kubectl{
+		Enabled: true,
+		Namespaces: config.Namespaces{
+			Include: []string{"team-a", "team-b"},
+		},
+		Commands: config.Commands{
+			Verbs:     []string{"get"},
+			Resources: []string{"pods"},
+		},
+		DefaultNamespace: "team-a",
+	}
+
+	tests := []struct {
+		name   string
+		args   []string
+		expMsg string
+	}{
+		{
+			name:   "Default namespace",
+			args:   []string{"check", "pods"},
+			expMsg: "Checked 2 pods on cluster 'test'.\n\nRecommendations:\n- Pod 'team-a/unlabeled' created without labels. Consider defining them, to be able to use them as a selector e.g. in Service.",
+		},
+		{
+			name:   "Namespace without recommendations",
+			args:   []string{"check", "pod", "-n", "team-b", "--cluster-name", "test"},
+			expMsg: "Checked 1 pods on cluster 'test'. No recommendations found.",
+		},
+		{
+			name:   "Named object",
+			args:   []string{"check", "pods", "labeled", "--namespace=team-a"},
+			expMsg: "Checked 1 pods on cluster 'test'. No recommendations found.",
+		},
+		{
+			name:   "Not existing object",
+			args:   []string{"check", "pods", "foo", "-n", "team-a"},
+			expMsg: "pods 'foo' not found in the 'team-a' Namespace on cluster 'test'.",
+		},
+		{
+			name:   "Namespace not allowed",
+			args:   []string{"check", "pods", "-n", "kube-system"},
+			expMsg: "Sorry, the kubectl 'get' command cannot be executed in the 'kube-system' Namespace on cluster 'test'. Use 'commands list' to see allowed commands.",
+		},
+		{
+			name:   "All namespaces not allowed",
+			args:   []string{"check", "pods", "-A"},
+			expMsg: "Sorry, the kubectl 'get' command cannot be executed for all Namespaces on cluster 'test'. Use 'commands list' to see allowed commands.",
+		},
+		{
+			name:   "Resource not allowed",
+			args:   []string{"check", "services"},
+			expMsg: "Sorry, the kubectl command is not authorized to work with 'services' resources in the 'team-a' Namespace on cluster 'test'. Use 'commands list' to see allowed commands.",
+		},
+		{
+			name:   "Unknown kind",
+			args:   []string{"check", "foos"},
+			expMsg: "Sorry, I don't know the 'foos' resource kind on cluster 'test'.",
+		},
+		{
+			name:   "Missing kind",
+			args:   []string{"check"},
+			expMsg: "Please specify the resource kind to check. Use: check <kind> [name] [-n namespace|-A]",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger, _ := logtest.NewNullLogger()
+			cfg := fixCfgWithKubectlExecutor(t, kubectlCfg)
+			cfg.Sources = map[string]config.Sources{
+				"k8s-events": {
+					Kubernetes: config.KubernetesSource{
+						Recommendations: config.Recommendations{
+							Pod: config.PodRecommendations{LabelsSet: ptr.Bool(true)},
+						},
+					},
+				},
+			}
+			merger := kubectl.NewMerger(cfg.Executors)
+			kcChecker := kubectl.NewChecker(nil)
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme,
+				fixCheckPod("team-a", "unlabeled", nil),
+				fixCheckPod("team-a", "labeled", map[string]string{"app": "api"}),
+				fixCheckPod("team-b", "labeled", map[string]string{"app": "api"}),
+			)
+
+			executor := NewCheckExecutor(
+				logger,
+				&fakeAnalyticsReporter{},
+				cfg,
+				NewKubectl(logger, cfg, merger, kcChecker, nil),
+				kcChecker,
+				merger,
+				recommendation.NewFactory(logger, dynamicCli),
+				dynamicCli,
+				fixCheckRESTMapper(),
+			)
+
+			// when
+			msg, err := executor.Do(context.Background(), tc.args, config.SocketSlackCommPlatformIntegration, Conversation{ExecutorBindings: fixBindingsNames, IsAuthenticated: true}, "test")
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expMsg, msg)
+		})
+	}
+}
+
+func fixCheckRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Service"}, meta.RESTScopeNamespace)
+	return mapper
+}
+
+func fixCheckPod(namespace, name string, labels map[string]string) *v1.Pod {
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+	}
+}
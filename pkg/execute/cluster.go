@@ -0,0 +1,214 @@
+package execute
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const (
+	clusterSelectedMsgFmt = "Sure! I will execute commands sent here without the cluster name on cluster '%s'."
+	clusterAllMsgFmt      = "Commands sent here without the cluster name will be executed on all clusters, including '%s'."
+	clusterNameMissingMsg = "You forgot to pass the cluster name. Please use 'use cluster <name>' or 'use cluster --all'."
+	clusterDeselectedFmt  = "Cluster '%s' won't execute commands sent here without the cluster name anymore, as only '%s' is selected. If no cluster confirms the selection, there is no cluster with such name. Use 'clusters list' to see the connected clusters, or 'use cluster --all' to select all of them."
+	clusterAllFlag        = "--all"
+)
+
+// ClusterAction defines the actions available for the `clusters` command.
+type ClusterAction string
+
+const (
+	// ClusterList lists the clusters connected to a given conversation.
+	ClusterList ClusterAction = "list"
+)
+
+// ClusterSessionKey identifies a user session within a given conversation.
+type ClusterSessionKey struct {
+	CommGroupName  string
+	Platform       config.CommPlatformIntegration
+	ConversationID string
+	User           string
+}
+
+// ClusterSessionStore holds in-memory cluster selection for user sessions.
+// Each BotKube instance keeps its own copy, as all of them receive the `use cluster` command.
+type ClusterSessionStore struct {
+	mu       sync.RWMutex
+	sessions map[ClusterSessionKey]map[string]struct{}
+}
+
+// NewClusterSessionStore returns a new ClusterSessionStore instance.
+func NewClusterSessionStore() *ClusterSessionStore {
+	return &ClusterSessionStore{
+		sessions: map[ClusterSessionKey]map[string]struct{}{},
+	}
+}
+
+// Select sets the selected clusters for a given session. Empty list clears the selection.
+func (s *ClusterSessionStore) Select(key ClusterSessionKey, clusterNames []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(clusterNames) == 0 {
+		delete(s.sessions, key)
+		return
+	}
+
+	selected := map[string]struct{}{}
+	for _, name := range clusterNames {
+		selected[name] = struct{}{}
+	}
+	s.sessions[key] = selected
+}
+
+// IsTargeted returns true if commands from a given session should be executed on a given cluster.
+// If there is no cluster selected, all clusters are targeted.
+func (s *ClusterSessionStore) IsTargeted(key ClusterSessionKey, clusterName string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	selected, found := s.sessions[key]
+	if !found {
+		return true
+	}
+
+	_, targeted := selected[clusterName]
+	return targeted
+}
+
+// Selected returns the sorted list of clusters selected for a given session.
+func (s *ClusterSessionStore) Selected(key ClusterSessionKey) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var out []string
+	for name := range s.sessions[key] {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ClusterExecutor executes all commands that are related to cluster selection.
+type ClusterExecutor struct {
+	log               logrus.FieldLogger
+	analyticsReporter AnalyticsReporter
+	sessions          *ClusterSessionStore
+}
+
+// NewClusterExecutor creates a new instance of ClusterExecutor.
+func NewClusterExecutor(log logrus.FieldLogger, analyticsReporter AnalyticsReporter, sessions *ClusterSessionStore) *ClusterExecutor {
+	return &ClusterExecutor{
+		log:               log,
+		analyticsReporter: analyticsReporter,
+		sessions:          sessions,
+	}
+}
+
+// SessionKey returns the cluster session key for a given user in a given conversation.
+// Bots pass the raw channel identifier for channels which are not configured, so such conversations don't share sessions.
+func (e *ClusterExecutor) SessionKey(commGroupName string, platform config.CommPlatformIntegration, conversation Conversation, user string) ClusterSessionKey {
+	return ClusterSessionKey{
+		CommGroupName:  commGroupName,
+		Platform:       platform,
+		ConversationID: conversation.ID,
+		User:           normalizeSessionUser(user),
+	}
+}
+
+// IsTargeted returns true if commands sent by a given user should be executed on a given cluster.
+func (e *ClusterExecutor) IsTargeted(key ClusterSessionKey, clusterName string) bool {
+	return e.sessions.IsTargeted(key, clusterName)
+}
+
+// Use executes the `use cluster` command.
+// Every instance stores the selection, but only the selected ones respond. Instances which were targeted before,
+// but are not selected anymore, respond as well, so the user gets feedback even if the selected cluster name is mistyped.
+func (e *ClusterExecutor) Use(args []string, key ClusterSessionKey, platform config.CommPlatformIntegration, conversation Conversation, clusterName string) (string, error) {
+	if len(args) < 2 || args[1] != "cluster" {
+		return "", errUnsupportedCommand
+	}
+	defer e.reportCommand(platform, "use cluster", conversation.IsButtonClickOrigin)
+
+	if len(args) < 3 {
+		return clusterNameMissingMsg, nil
+	}
+	if len(args) > 3 {
+		return "", errInvalidCommand
+	}
+
+	if args[2] == clusterAllFlag {
+		e.sessions.Select(key, nil)
+		return fmt.Sprintf(clusterAllMsgFmt, clusterName), nil
+	}
+
+	var names []string
+	for _, name := range strings.Split(args[2], ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return clusterNameMissingMsg, nil
+	}
+
+	wasTargeted := e.sessions.IsTargeted(key, clusterName)
+	e.sessions.Select(key, names)
+	if !e.sessions.IsTargeted(key, clusterName) {
+		if wasTargeted {
+			return fmt.Sprintf(clusterDeselectedFmt, clusterName, strings.Join(names, ",")), nil
+		}
+		// other instance is selected, stay silent
+		return "", nil
+	}
+
+	return fmt.Sprintf(clusterSelectedMsgFmt, clusterName), nil
+}
+
+// List executes the `clusters list` command. Each instance responds with its own status.
+func (e *ClusterExecutor) List(args []string, key ClusterSessionKey, platform config.CommPlatformIntegration, conversation Conversation, clusterName string) (string, error) {
+	if len(args) > 2 || (len(args) == 2 && ClusterAction(args[1]) != ClusterList) {
+		return "", errInvalidCommand
+	}
+	defer e.reportCommand(platform, fmt.Sprintf("clusters %s", ClusterList), conversation.IsButtonClickOrigin)
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "CLUSTER\tTARGETED\tSELECTED")
+	fmt.Fprintf(w, "%s\t%t\t%s\n", clusterName, e.sessions.IsTargeted(key, clusterName), e.selectedDescription(key))
+	w.Flush()
+
+	return buf.String(), nil
+}
+
+func (e *ClusterExecutor) selectedDescription(key ClusterSessionKey) string {
+	selected := e.sessions.Selected(key)
+	if len(selected) == 0 {
+		return "all"
+	}
+	return strings.Join(selected, ",")
+}
+
+func (e *ClusterExecutor) reportCommand(platform config.CommPlatformIntegration, cmd string, isButtonClickOrigin bool) {
+	err := e.analyticsReporter.ReportCommand(platform, cmd, isButtonClickOrigin)
+	if err != nil {
+		// TODO: Return error when the DefaultExecutor is refactored as a part of https://github.com/kubeshop/botkube/issues/589
+		e.log.Errorf("while reporting cluster command: %s", err.Error())
+	}
+}
+
+// normalizeSessionUser unifies the user identifiers, as some bots pass them as mentions (e.g. `<@U123>`)
+// and others as raw IDs, depending on the event type.
+func normalizeSessionUser(user string) string {
+	user = strings.TrimPrefix(user, "<@")
+	return strings.TrimSuffix(user, ">")
+}
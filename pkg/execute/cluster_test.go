@@ -0,0 +1,152 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestClusterExecutor_Use(t *testing.T) {
+	// given
+	log, _ := logtest.NewNullLogger()
+	platform := config.SlackCommPlatformIntegration
+	conversation := Conversation{ID: "conv-id"}
+
+	testCases := []struct {
+		Name                 string
+		Selected             []string
+		InputArgs            []string
+		ExpectedResult       string
+		ExpectedTargeted     bool
+		ExpectedErrorMessage string
+	}{
+		{
+			Name:             "Select current cluster",
+			InputArgs:        []string{"use", "cluster", "prod"},
+			ExpectedResult:   "Sure! I will execute commands sent here without the cluster name on cluster 'prod'.",
+			ExpectedTargeted: true,
+		},
+		{
+			Name:             "Select multiple clusters",
+			InputArgs:        []string{"use", "cluster", "dev,prod"},
+			ExpectedResult:   "Sure! I will execute commands sent here without the cluster name on cluster 'prod'.",
+			ExpectedTargeted: true,
+		},
+		{
+			Name:             "Select other cluster",
+			InputArgs:        []string{"use", "cluster", "dev"},
+			ExpectedResult:   "Cluster 'prod' won't execute commands sent here without the cluster name anymore, as only 'dev' is selected. If no cluster confirms the selection, there is no cluster with such name. Use 'clusters list' to see the connected clusters, or 'use cluster --all' to select all of them.",
+			ExpectedTargeted: false,
+		},
+		{
+			Name:             "Select other cluster when other one was selected",
+			Selected:         []string{"stage"},
+			InputArgs:        []string{"use", "cluster", "dev"},
+			ExpectedResult:   "",
+			ExpectedTargeted: false,
+		},
+		{
+			Name:             "Select all clusters",
+			InputArgs:        []string{"use", "cluster", "--all"},
+			ExpectedResult:   "Commands sent here without the cluster name will be executed on all clusters, including 'prod'.",
+			ExpectedTargeted: true,
+		},
+		{
+			Name:             "Missing cluster name",
+			InputArgs:        []string{"use", "cluster"},
+			ExpectedResult:   "You forgot to pass the cluster name. Please use 'use cluster <name>' or 'use cluster --all'.",
+			ExpectedTargeted: true,
+		},
+		{
+			Name:                 "Unsupported command",
+			InputArgs:            []string{"use", "context", "prod"},
+			ExpectedErrorMessage: "unsupported command",
+		},
+		{
+			Name:                 "Invalid command",
+			InputArgs:            []string{"use", "cluster", "prod", "dev"},
+			ExpectedErrorMessage: "invalid command",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			e := NewClusterExecutor(log, &fakeAnalyticsReporter{}, NewClusterSessionStore())
+			key := e.SessionKey("comm-group", platform, conversation, "<@U123>")
+			e.sessions.Select(key, tc.Selected)
+
+			// when
+			actual, err := e.Use(tc.InputArgs, key, platform, conversation, "prod")
+
+			// then
+			if tc.ExpectedErrorMessage != "" {
+				require.NotNil(t, err)
+				assert.EqualError(t, err, tc.ExpectedErrorMessage)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedResult, actual)
+			assert.Equal(t, tc.ExpectedTargeted, e.IsTargeted(key, "prod"))
+		})
+	}
+}
+
+func TestClusterExecutor_SessionsAreIsolated(t *testing.T) {
+	// given
+	log, _ := logtest.NewNullLogger()
+	platform := config.SlackCommPlatformIntegration
+	conversation := Conversation{ID: "conv-id"}
+	e := NewClusterExecutor(log, &fakeAnalyticsReporter{}, NewClusterSessionStore())
+
+	userKey := e.SessionKey("comm-group", platform, conversation, "<@U123>")
+	sameUserButtonKey := e.SessionKey("comm-group", platform, conversation, "U123")
+	otherUserKey := e.SessionKey("comm-group", platform, conversation, "<@U456>")
+	otherConvKey := e.SessionKey("comm-group", platform, Conversation{ID: "other"}, "<@U123>")
+
+	// when
+	_, err := e.Use([]string{"use", "cluster", "dev"}, userKey, platform, conversation, "prod")
+	require.NoError(t, err)
+
+	// then
+	assert.False(t, e.IsTargeted(userKey, "prod"))
+	assert.False(t, e.IsTargeted(sameUserButtonKey, "prod"))
+	assert.True(t, e.IsTargeted(otherUserKey, "prod"))
+	assert.True(t, e.IsTargeted(otherConvKey, "prod"))
+}
+
+func TestClusterExecutor_List(t *testing.T) {
+	// given
+	log, _ := logtest.NewNullLogger()
+	platform := config.SlackCommPlatformIntegration
+	conversation := Conversation{ID: "conv-id"}
+	e := NewClusterExecutor(log, &fakeAnalyticsReporter{}, NewClusterSessionStore())
+	key := e.SessionKey("comm-group", platform, conversation, "U123")
+
+	// when
+	allOut, err := e.List([]string{"clusters", "list"}, key, platform, conversation, "prod")
+	require.NoError(t, err)
+
+	_, err = e.Use([]string{"use", "cluster", "dev,stage"}, key, platform, conversation, "prod")
+	require.NoError(t, err)
+	selectedOut, err := e.List([]string{"clusters"}, key, platform, conversation, "prod")
+	require.NoError(t, err)
+
+	_, err = e.List([]string{"clusters", "delete"}, key, platform, conversation, "prod")
+
+	// then
+	assert.Equal(t, heredoc.Doc(`
+		CLUSTER TARGETED SELECTED
+		prod    true     all
+	`), allOut)
+	assert.Equal(t, heredoc.Doc(`
+		CLUSTER TARGETED SELECTED
+		prod    false    dev,stage
+	`), selectedOut)
+	assert.EqualError(t, err, "invalid command")
+}
@@ -0,0 +1,37 @@
+package execute
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+// ConfirmCommand is the command which asks for a confirmation before running a given command, e.g. `confirm rollout restart deployments/foo`.
+// It is used by buttons which run commands changing the cluster state.
+const ConfirmCommand = "confirm"
+
+const confirmMsgFmt = "Do you want to run `%s` on `%s`?"
+
+// confirmPrompt returns a message with a button which runs a given command.
+// The command itself is checked against the executor configuration only once the button is clicked.
+func confirmPrompt(args []string, clusterName, botName string) (interactive.Message, error) {
+	if len(args) < 2 {
+		return interactive.Message{}, errInvalidCommand
+	}
+
+	cmd := strings.Join(args[1:], " ")
+	btn := interactive.NewButtonBuilder(botName).ForCommand("Confirm", cmd)
+	btn.Style = interactive.ButtonStyleDanger
+
+	return interactive.Message{
+		Base: interactive.Base{
+			Description: fmt.Sprintf(confirmMsgFmt, cmd, clusterName),
+		},
+		Sections: []interactive.Section{
+			{
+				Buttons: interactive.Buttons{btn},
+			},
+		},
+	}, nil
+}
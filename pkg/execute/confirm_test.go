@@ -0,0 +1,35 @@
+package execute
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+)
+
+func TestConfirmPrompt(t *testing.T) {
+	// given
+	args := []string{"confirm", "rollout", "restart", "deployments/api", "-n", "team-a"}
+
+	expBtn := interactive.NewButtonBuilder("@Botkube").ForCommand("Confirm", "rollout restart deployments/api -n team-a")
+	expBtn.Style = interactive.ButtonStyleDanger
+
+	// when
+	msg, err := confirmPrompt(args, "test", "@Botkube")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Do you want to run `rollout restart deployments/api -n team-a` on `test`?", msg.Description)
+	require.Len(t, msg.Sections, 1)
+	assert.Equal(t, interactive.Buttons{expBtn}, msg.Sections[0].Buttons)
+}
+
+func TestConfirmPromptMissingCommand(t *testing.T) {
+	// when
+	_, err := confirmPrompt([]string{"confirm"}, "test", "@Botkube")
+
+	// then
+	assert.ErrorIs(t, err, errInvalidCommand)
+}
@@ -114,7 +114,7 @@ func (e *EditExecutor) editSourceBindingHandler(cmdArgs []string, commGroupName
 	}
 
 	if len(sourceBindings) == 0 {
-		selectedOptions := e.currentlySelectedOptions(commGroupName, platform, conversation.ID)
+		selectedOptions := e.currentlySelectedOptions(commGroupName, platform, conversation)
 		return interactive.Message{
 			Type: interactive.Popup,
 			Base: interactive.Base{
@@ -179,7 +179,8 @@ func (e *EditExecutor) generateUnknownMessage(unknown []string) interactive.Mess
 	}
 }
 
-func (e *EditExecutor) currentlySelectedOptions(commGroupName string, platform config.CommPlatformIntegration, conversationID string) []interactive.OptionItem {
+func (e *EditExecutor) currentlySelectedOptions(commGroupName string, platform config.CommPlatformIntegration, conversation Conversation) []interactive.OptionItem {
+	conversationID := conversation.ID
 	switch platform {
 	case config.SlackCommPlatformIntegration:
 		channels := e.cfg.Communications[commGroupName].Slack.Channels
@@ -214,7 +215,11 @@ func (e *EditExecutor) currentlySelectedOptions(commGroupName string, platform c
 			return e.mapToOptions(channel.Bindings.Sources)
 		}
 	case config.TeamsCommPlatformIntegration:
-		return e.mapToOptions(e.cfg.Communications[commGroupName].Teams.Bindings.Sources)
+		teams := e.cfg.Communications[commGroupName].Teams
+		if channel, found := teams.Channels[conversation.Alias]; found {
+			return e.mapToOptions(channel.Bindings.Sources)
+		}
+		return e.mapToOptions(teams.Bindings.Sources)
 	}
 	return nil
 }
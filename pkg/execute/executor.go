@@ -55,6 +55,9 @@ type DefaultExecutor struct {
 	kubectlExecutor   *Kubectl
 	editExecutor      *EditExecutor
 	notifierExecutor  *NotifierExecutor
+	clusterExecutor   *ClusterExecutor
+	checkExecutor     *CheckExecutor
+	statusExecutor    *StatusExecutor
 	notifierHandler   NotifierHandler
 	message           string
 	platform          config.CommPlatformIntegration
@@ -74,6 +77,7 @@ const (
 	Stop       NotifierAction = "stop"
 	Status     NotifierAction = "status"
 	ShowConfig NotifierAction = "showconfig"
+	Schedule   NotifierAction = "schedule"
 )
 
 func (action NotifierAction) String() string {
@@ -165,6 +169,15 @@ func (e *DefaultExecutor) Execute() interactive.Message {
 		return empty // user specified different target cluster
 	}
 
+	sessionKey := e.clusterExecutor.SessionKey(e.commGroupName, e.platform, e.conversation, e.user)
+	if inClusterName == "" && !isClusterSelectionCommand(args) && !e.clusterExecutor.IsTargeted(sessionKey, clusterName) {
+		e.log.WithFields(logrus.Fields{
+			"config-cluster-name": clusterName,
+			"conversation-id":     e.conversation.ID,
+		}).Debugf("Different cluster is selected for this session. Ignoring further execution...")
+		return empty // user selected different target cluster
+	}
+
 	if e.kubectlExecutor.CanHandle(e.conversation.ExecutorBindings, args) {
 		cmdPrefix := e.kubectlExecutor.GetCommandPrefix(args)
 		err := e.analyticsReporter.ReportCommand(e.platform, cmdPrefix, e.conversation.IsButtonClickOrigin)
@@ -211,6 +224,27 @@ func (e *DefaultExecutor) Execute() interactive.Message {
 		"edit": func() (interactive.Message, error) {
 			return e.editExecutor.Do(args, e.commGroupName, e.platform, e.conversation, e.user, e.notifierHandler.BotName())
 		},
+		"use": func() (interactive.Message, error) {
+			res, err := e.clusterExecutor.Use(args, sessionKey, e.platform, e.conversation, clusterName)
+			if err == nil && res == "" {
+				return empty, nil
+			}
+			return response(res, ""), err
+		},
+		"clusters": func() (interactive.Message, error) {
+			res, err := e.clusterExecutor.List(args, sessionKey, e.platform, e.conversation, clusterName)
+			return response(res, ""), err
+		},
+		"check": func() (interactive.Message, error) {
+			res, err := e.checkExecutor.Do(ctx, args, e.platform, e.conversation, clusterName)
+			return response(res, ""), err
+		},
+		"status": func() (interactive.Message, error) {
+			return e.statusExecutor.Do(ctx, args, e.platform, e.conversation, clusterName, e.notifierHandler.BotName())
+		},
+		ConfirmCommand: func() (interactive.Message, error) {
+			return confirmPrompt(args, clusterName, e.notifierHandler.BotName())
+		},
 		"feedback": func() (interactive.Message, error) {
 			return interactive.Feedback(), nil
 		},
@@ -232,6 +266,15 @@ func (e *DefaultExecutor) Execute() interactive.Message {
 	return msg
 }
 
+// isClusterSelectionCommand returns true for commands which must be handled by all instances, regardless the selected cluster.
+func isClusterSelectionCommand(args []string) bool {
+	switch strings.ToLower(args[0]) {
+	case "use", "clusters":
+		return true
+	}
+	return false
+}
+
 // TODO: Refactor as a part of https://github.com/kubeshop/botkube/issues/657
 // runFilterCommand to list, enable or disable filters
 func (e *DefaultExecutor) runFilterCommand(ctx context.Context, args []string, clusterName string) (string, error) {
@@ -2,8 +2,11 @@ package execute
 
 import (
 	"context"
+	"sync"
 
 	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/dynamic"
 
 	"github.com/kubeshop/botkube/pkg/bot/interactive"
 	"github.com/kubeshop/botkube/pkg/config"
@@ -21,8 +24,18 @@ type DefaultExecutorFactory struct {
 	notifierExecutor  *NotifierExecutor
 	kubectlExecutor   *Kubectl
 	editExecutor      *EditExecutor
+	clusterExecutor   *ClusterExecutor
+	checkExecutor     *CheckExecutor
+	statusExecutor    *StatusExecutor
 	merger            *kubectl.Merger
 	cfgManager        ConfigPersistenceManager
+	kcChecker         *kubectl.Checker
+	recommFactory     RecommendationFactory
+	dynamicCli        dynamic.Interface
+	mapper            meta.RESTMapper
+
+	// cfgMutex guards the configuration and executors which depend on it, as they are replaced on configuration reload.
+	cfgMutex sync.RWMutex
 }
 
 // DefaultExecutorFactoryParams contains input parameters for DefaultExecutorFactory.
@@ -35,6 +48,9 @@ type DefaultExecutorFactoryParams struct {
 	Merger            *kubectl.Merger
 	CfgManager        ConfigPersistenceManager
 	AnalyticsReporter AnalyticsReporter
+	RecommFactory     RecommendationFactory
+	DynamicCli        dynamic.Interface
+	Mapper            meta.RESTMapper
 }
 
 // Executor is an interface for processes to execute commands
@@ -46,6 +62,7 @@ type Executor interface {
 type ConfigPersistenceManager interface {
 	PersistSourceBindings(ctx context.Context, commGroupName string, platform config.CommPlatformIntegration, channelAlias string, sourceBindings []string) error
 	PersistNotificationsEnabled(ctx context.Context, commGroupName string, platform config.CommPlatformIntegration, channelAlias string, enabled bool) error
+	PersistQuietHours(ctx context.Context, commGroupName string, platform config.CommPlatformIntegration, channelAlias string, quietHours config.QuietHours) error
 	PersistFilterEnabled(ctx context.Context, name string, enabled bool) error
 }
 
@@ -57,34 +74,75 @@ type AnalyticsReporter interface {
 
 // NewExecutorFactory creates new DefaultExecutorFactory.
 func NewExecutorFactory(params DefaultExecutorFactoryParams) *DefaultExecutorFactory {
-	return &DefaultExecutorFactory{
+	kcExecutor := NewKubectl(
+		params.Log.WithField("component", "Kubectl Executor"),
+		params.Cfg,
+		params.Merger,
+		params.KcChecker,
+		params.CmdRunner,
+	)
+	f := &DefaultExecutorFactory{
 		log:               params.Log,
 		cmdRunner:         params.CmdRunner,
-		cfg:               params.Cfg,
 		filterEngine:      params.FilterEngine,
 		analyticsReporter: params.AnalyticsReporter,
-		notifierExecutor: NewNotifierExecutor(
-			params.Log.WithField("component", "Notifier Executor"),
-			params.Cfg,
-			params.CfgManager,
+		clusterExecutor: NewClusterExecutor(
+			params.Log.WithField("component", "Cluster Executor"),
 			params.AnalyticsReporter,
+			NewClusterSessionStore(),
 		),
-		editExecutor: NewEditExecutor(
-			params.Log.WithField("component", "Notifier Executor"),
+		statusExecutor: NewStatusExecutor(
+			params.Log.WithField("component", "Status Executor"),
 			params.AnalyticsReporter,
-			params.CfgManager,
-			params.Cfg,
-		),
-		merger:     params.Merger,
-		cfgManager: params.CfgManager,
-		kubectlExecutor: NewKubectl(
-			params.Log.WithField("component", "Kubectl Executor"),
-			params.Cfg,
-			params.Merger,
 			params.KcChecker,
-			params.CmdRunner,
+			params.Merger,
+			params.DynamicCli,
 		),
+		merger:          params.Merger,
+		cfgManager:      params.CfgManager,
+		kubectlExecutor: kcExecutor,
+		kcChecker:       params.KcChecker,
+		recommFactory:   params.RecommFactory,
+		dynamicCli:      params.DynamicCli,
+		mapper:          params.Mapper,
 	}
+	f.setConfig(params.Cfg)
+	return f
+}
+
+// SetConfig replaces the configuration used by newly created executors.
+// It is used to apply the sources and bindings configuration reloaded without restart.
+func (f *DefaultExecutorFactory) SetConfig(cfg config.Config) {
+	f.cfgMutex.Lock()
+	defer f.cfgMutex.Unlock()
+	f.setConfig(cfg)
+}
+
+func (f *DefaultExecutorFactory) setConfig(cfg config.Config) {
+	f.cfg = cfg
+	f.notifierExecutor = NewNotifierExecutor(
+		f.log.WithField("component", "Notifier Executor"),
+		cfg,
+		f.cfgManager,
+		f.analyticsReporter,
+	)
+	f.editExecutor = NewEditExecutor(
+		f.log.WithField("component", "Notifier Executor"),
+		f.analyticsReporter,
+		f.cfgManager,
+		cfg,
+	)
+	f.checkExecutor = NewCheckExecutor(
+		f.log.WithField("component", "Check Executor"),
+		f.analyticsReporter,
+		cfg,
+		f.kubectlExecutor,
+		f.kcChecker,
+		f.merger,
+		f.recommFactory,
+		f.dynamicCli,
+		f.mapper,
+	)
 }
 
 // Conversation contains details about the conversation.
@@ -108,6 +166,9 @@ type NewDefaultInput struct {
 
 // NewDefault creates new Default Executor.
 func (f *DefaultExecutorFactory) NewDefault(cfg NewDefaultInput) Executor {
+	f.cfgMutex.RLock()
+	defer f.cfgMutex.RUnlock()
+
 	return &DefaultExecutor{
 		log:               f.log,
 		cmdRunner:         f.cmdRunner,
@@ -116,6 +177,9 @@ func (f *DefaultExecutorFactory) NewDefault(cfg NewDefaultInput) Executor {
 		kubectlExecutor:   f.kubectlExecutor,
 		notifierExecutor:  f.notifierExecutor,
 		editExecutor:      f.editExecutor,
+		clusterExecutor:   f.clusterExecutor,
+		checkExecutor:     f.checkExecutor,
+		statusExecutor:    f.statusExecutor,
 		filterEngine:      f.filterEngine,
 		merger:            f.merger,
 		cfgManager:        f.cfgManager,
@@ -31,6 +31,13 @@ func (f *fakeCfgPersistenceManager) PersistNotificationsEnabled(ctx context.Cont
 	return nil
 }
 
+func (f *fakeCfgPersistenceManager) PersistQuietHours(ctx context.Context, commGroupName string, platform config.CommPlatformIntegration, channelAlias string, quietHours config.QuietHours) error {
+	if f.expectedAlias != channelAlias {
+		return errors.New("different alias")
+	}
+	return nil
+}
+
 func (f *fakeCfgPersistenceManager) PersistFilterEnabled(ctx context.Context, name string, enabled bool) error {
 	return nil
 }
@@ -42,6 +42,12 @@ var resourcelessCommands = map[string]struct{}{
 	"run":          {},
 }
 
+// subcommandVerbs holds all commands that specify resources after a subcommand. For example:
+// - kubectl rollout restart deployment/foo
+var subcommandVerbs = map[string]struct{}{
+	"rollout": {},
+}
+
 // Kubectl executes kubectl commands using local binary.
 type Kubectl struct {
 	log logrus.FieldLogger
@@ -303,10 +309,17 @@ func (e *Kubectl) addNamespaceFlag(args []string, defaultNamespace string) []str
 }
 
 func (e *Kubectl) getResourceName(args []string) string {
-	if len(args) < 2 {
+	idx := 1
+	if len(args) > 0 {
+		if _, found := subcommandVerbs[args[0]]; found {
+			idx = 2
+		}
+	}
+
+	if len(args) <= idx {
 		return ""
 	}
-	resource, _, _ := strings.Cut(args[1], "/")
+	resource, _, _ := strings.Cut(args[idx], "/")
 	return resource
 }
 
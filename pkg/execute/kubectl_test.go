@@ -336,6 +336,42 @@ func TestKubectlExecute(t *testing.T) {
 			expKubectlExecuted: true,
 			expOutMsg:          "kubectl executed",
 		},
+		{
+			name: "Should allow rollout execution based on the resource specified after the subcommand",
+
+			command: "rollout restart deployments/api -n team-a",
+			kubectlCfg: config.Kubectl{
+				Enabled: true,
+				Namespaces: config.Namespaces{
+					Include: []string{"team-a"},
+				},
+				Commands: config.Commands{
+					Verbs:     []string{"rollout"},
+					Resources: []string{"deployments"},
+				},
+			},
+
+			expKubectlExecuted: true,
+			expOutMsg:          "kubectl executed",
+		},
+		{
+			name: "Should forbid rollout execution if resource specified after the subcommand is not allowed in config",
+
+			command: "rollout restart daemonsets/agent -n team-a",
+			kubectlCfg: config.Kubectl{
+				Enabled: true,
+				Namespaces: config.Namespaces{
+					Include: []string{"team-a"},
+				},
+				Commands: config.Commands{
+					Verbs:     []string{"rollout"},
+					Resources: []string{"deployments"},
+				},
+			},
+
+			expKubectlExecuted: false,
+			expOutMsg:          "Sorry, the kubectl command is not authorized to work with 'daemonsets' resources in the 'team-a' Namespace on cluster 'test'. Use 'commands list' to see allowed commands.",
+		},
 		{
 			name: "Known limitation (since v0.12.4): Return error if flag is added before resource name",
 
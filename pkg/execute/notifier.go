@@ -18,6 +18,15 @@ const (
 	notifierStatusMsgFmt               = "Notifications from cluster '%s' are %s here."
 	notifierNotConfiguredMsgFmt        = "I'm not configured to send notifications here ('%s') from cluster '%s', so you cannot turn them on or off."
 	notifierPersistenceNotSupportedFmt = "Platform %q doesn't support persistence for notifications. When BotKube Pod restarts, default notification settings will be applied for this platform."
+
+	quietHoursSetMsgFmt        = "Got it! Between %s and %s (%s), I'll send you only notifications with at least %q level from cluster '%s' here. Other events will be summarized once quiet hours are over."
+	quietHoursDisabledMsgFmt   = "Sure! Quiet hours for notifications from cluster '%s' are disabled here."
+	quietHoursStatusMsgFmt     = "Quiet hours for notifications from cluster '%s' are set to %s here."
+	quietHoursNoStatusMsgFmt   = "Quiet hours for notifications from cluster '%s' are not set here."
+	quietHoursNotConfiguredFmt = "I'm not configured to send notifications here ('%s') from cluster '%s', so you cannot set quiet hours."
+	quietHoursInvalidMsgFmt    = "Invalid quiet hours: %s. Use: notifier schedule [HH:MM-HH:MM [timezone] [min-level]|off]"
+	quietHoursOffArg           = "off"
+	quietHoursMaxArgs          = 3
 )
 
 // NotifierHandler handles disabling and enabling notifications for a given communication platform.
@@ -28,6 +37,12 @@ type NotifierHandler interface {
 	// SetNotificationsEnabled sets a new notification status for a given conversation ID.
 	SetNotificationsEnabled(conversationID string, enabled bool) error
 
+	// QuietHours returns quiet hours for a given conversation ID.
+	QuietHours(conversationID string) config.QuietHours
+
+	// SetQuietHours sets quiet hours for a given conversation ID. Empty quiet hours disable them.
+	SetQuietHours(conversationID string, quietHours config.QuietHours) error
+
 	BotName() string
 }
 
@@ -58,7 +73,8 @@ func NewNotifierExecutor(log logrus.FieldLogger, cfg config.Config, cfgManager C
 
 // Do executes a given Notifier command based on args.
 func (e *NotifierExecutor) Do(ctx context.Context, args []string, commGroupName string, platform config.CommPlatformIntegration, conversation Conversation, clusterName string, handler NotifierHandler) (string, error) {
-	if len(args) != 2 {
+	// only the `schedule` verb accepts additional arguments
+	if len(args) < 2 || (len(args) > 2 && !strings.EqualFold(args[1], Schedule.String())) {
 		return "", errInvalidCommand
 	}
 
@@ -139,6 +155,8 @@ func (e *NotifierExecutor) Do(ctx context.Context, args []string, commGroupName
 		}
 
 		return fmt.Sprintf("Showing config for cluster %q:\n\n%s", clusterName, out), nil
+	case Schedule:
+		return e.schedule(ctx, args[2:], commGroupName, platform, conversation, clusterName, handler)
 	default:
 		isUnknownVerb = true
 	}
@@ -146,6 +164,81 @@ func (e *NotifierExecutor) Do(ctx context.Context, args []string, commGroupName
 	return "", errUnsupportedCommand
 }
 
+// schedule shows, sets or disables quiet hours for a given conversation.
+func (e *NotifierExecutor) schedule(ctx context.Context, args []string, commGroupName string, platform config.CommPlatformIntegration, conversation Conversation, clusterName string, handler NotifierHandler) (string, error) {
+	if len(args) == 0 {
+		quietHours := handler.QuietHours(conversation.ID)
+		if !quietHours.IsEnabled() {
+			return fmt.Sprintf(quietHoursNoStatusMsgFmt, clusterName), nil
+		}
+		return fmt.Sprintf(quietHoursStatusMsgFmt, clusterName, quietHours.String()), nil
+	}
+
+	quietHours, err := parseQuietHours(args)
+	if err != nil {
+		return fmt.Sprintf(quietHoursInvalidMsgFmt, err.Error()), nil
+	}
+
+	err = handler.SetQuietHours(conversation.ID, quietHours)
+	if err != nil {
+		if errors.Is(err, ErrNotificationsNotConfigured) {
+			return fmt.Sprintf(quietHoursNotConfiguredFmt, conversation.ID, clusterName), nil
+		}
+
+		return "", fmt.Errorf("while setting quiet hours: %w", err)
+	}
+
+	successMessage := fmt.Sprintf(quietHoursDisabledMsgFmt, clusterName)
+	if quietHours.IsEnabled() {
+		successMessage = fmt.Sprintf(quietHoursSetMsgFmt, quietHours.Start, quietHours.End, quietHours.TimezoneOrDefault(), quietHours.MinLevelOrDefault(), clusterName)
+	}
+
+	err = e.cfgManager.PersistQuietHours(ctx, commGroupName, platform, conversation.Alias, quietHours)
+	if err != nil {
+		if err == config.ErrUnsupportedPlatform {
+			e.log.Warnf(notifierPersistenceNotSupportedFmt, platform)
+			return successMessage, nil
+		}
+
+		return "", fmt.Errorf("while persisting configuration: %w", err)
+	}
+
+	return successMessage, nil
+}
+
+// parseQuietHours parses the `notifier schedule` arguments in the `HH:MM-HH:MM [timezone] [min-level]` or `off` format.
+func parseQuietHours(args []string) (config.QuietHours, error) {
+	if len(args) == 1 && strings.EqualFold(args[0], quietHoursOffArg) {
+		return config.QuietHours{}, nil
+	}
+
+	if len(args) > quietHoursMaxArgs {
+		return config.QuietHours{}, errors.New("too many arguments")
+	}
+
+	start, end, found := strings.Cut(args[0], "-")
+	if !found {
+		return config.QuietHours{}, fmt.Errorf("time range %q should be in the HH:MM-HH:MM format", args[0])
+	}
+
+	quietHours := config.QuietHours{
+		Start: start,
+		End:   end,
+	}
+	if len(args) > 1 {
+		quietHours.Timezone = args[1]
+	}
+	if len(args) > 2 {
+		quietHours.MinLevel = config.Level(strings.ToLower(args[2]))
+	}
+
+	if err := quietHours.Validate(); err != nil {
+		return config.QuietHours{}, err
+	}
+
+	return quietHours, nil
+}
+
 const redactedSecretStr = "*** REDACTED ***"
 
 // Deprecated: this function doesn't fit in the scope of notifier. It was moved from legacy reasons, but it will be removed in future.
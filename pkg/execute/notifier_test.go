@@ -113,6 +113,7 @@ func TestNotifierExecutor_Do_Success(t *testing.T) {
 				        disableColors: false
 				    informersResyncPeriod: 0s
 				    kubeconfig: ""
+				    eventsResource: ""
 				configWatcher:
 				    enabled: false
 				    initialSyncTimeout: 0s
@@ -170,8 +171,93 @@ func TestNotifierExecutor_Do_Success(t *testing.T) {
 	}
 }
 
+func TestNotifierExecutor_Do_Schedule(t *testing.T) {
+	// given
+	log, _ := logtest.NewNullLogger()
+	platform := config.SlackCommPlatformIntegration
+	channelAlias := "alias"
+	commGroupName := "comm-group"
+	clusterName := "cluster-name"
+	statusArgs := []string{"notifier", "schedule"}
+
+	testCases := []struct {
+		Name                string
+		InputArgs           []string
+		Conversation        Conversation
+		ExpectedResult      string
+		ExpectedStatusAfter string
+	}{
+		{
+			Name:                "Set quiet hours",
+			InputArgs:           []string{"notifier", "schedule", "22:00-07:00", "Europe/Warsaw", "Critical"},
+			Conversation:        Conversation{Alias: channelAlias, ID: "conv-id"},
+			ExpectedResult:      `Got it! Between 22:00 and 07:00 (Europe/Warsaw), I'll send you only notifications with at least "critical" level from cluster 'cluster-name' here. Other events will be summarized once quiet hours are over.`,
+			ExpectedStatusAfter: `Quiet hours for notifications from cluster 'cluster-name' are set to 22:00-07:00 Europe/Warsaw (min level: critical) here.`,
+		},
+		{
+			Name:                "Set quiet hours with defaults",
+			InputArgs:           []string{"notifier", "schedule", "22:00-07:00"},
+			Conversation:        Conversation{Alias: channelAlias, ID: "conv-id"},
+			ExpectedResult:      `Got it! Between 22:00 and 07:00 (UTC), I'll send you only notifications with at least "error" level from cluster 'cluster-name' here. Other events will be summarized once quiet hours are over.`,
+			ExpectedStatusAfter: `Quiet hours for notifications from cluster 'cluster-name' are set to 22:00-07:00 UTC (min level: error) here.`,
+		},
+		{
+			Name:                "Disable quiet hours",
+			InputArgs:           []string{"notifier", "schedule", "off"},
+			Conversation:        Conversation{Alias: channelAlias, ID: "conv-id"},
+			ExpectedResult:      `Sure! Quiet hours for notifications from cluster 'cluster-name' are disabled here.`,
+			ExpectedStatusAfter: `Quiet hours for notifications from cluster 'cluster-name' are not set here.`,
+		},
+		{
+			Name:                "Invalid time range",
+			InputArgs:           []string{"notifier", "schedule", "22:00"},
+			Conversation:        Conversation{Alias: channelAlias, ID: "conv-id"},
+			ExpectedResult:      `Invalid quiet hours: time range "22:00" should be in the HH:MM-HH:MM format. Use: notifier schedule [HH:MM-HH:MM [timezone] [min-level]|off]`,
+			ExpectedStatusAfter: `Quiet hours for notifications from cluster 'cluster-name' are not set here.`,
+		},
+		{
+			Name:                "Invalid time zone",
+			InputArgs:           []string{"notifier", "schedule", "22:00-07:00", "Mars/Olympus"},
+			Conversation:        Conversation{Alias: channelAlias, ID: "conv-id"},
+			ExpectedResult:      `Invalid quiet hours: invalid quiet hours time zone "Mars/Olympus": unknown time zone Mars/Olympus. Use: notifier schedule [HH:MM-HH:MM [timezone] [min-level]|off]`,
+			ExpectedStatusAfter: `Quiet hours for notifications from cluster 'cluster-name' are not set here.`,
+		},
+		{
+			Name:                "Non-configured channel",
+			InputArgs:           []string{"notifier", "schedule", "22:00-07:00"},
+			Conversation:        Conversation{Alias: channelAlias, ID: "non-existing"},
+			ExpectedResult:      `I'm not configured to send notifications here ('non-existing') from cluster 'cluster-name', so you cannot set quiet hours.`,
+			ExpectedStatusAfter: `Quiet hours for notifications from cluster 'cluster-name' are not set here.`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			e := NewNotifierExecutor(log, config.Config{}, &fakeCfgPersistenceManager{expectedAlias: channelAlias}, &fakeAnalyticsReporter{})
+			handler := &fakeNotifierHandler{
+				conf: map[string]bool{"conv-id": true},
+			}
+
+			// when
+			actual, err := e.Do(context.Background(), tc.InputArgs, commGroupName, platform, tc.Conversation, clusterName, handler)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedResult, actual)
+
+			// when
+			actual, err = e.Do(context.Background(), statusArgs, commGroupName, platform, tc.Conversation, clusterName, handler)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedStatusAfter, actual)
+		})
+	}
+}
+
 type fakeNotifierHandler struct {
-	conf map[string]bool
+	conf       map[string]bool
+	quietHours map[string]config.QuietHours
 }
 
 func (f *fakeNotifierHandler) NotificationsEnabled(convID string) bool {
@@ -193,6 +279,23 @@ func (f *fakeNotifierHandler) SetNotificationsEnabled(convID string, enabled boo
 	return nil
 }
 
+func (f *fakeNotifierHandler) QuietHours(convID string) config.QuietHours {
+	return f.quietHours[convID]
+}
+
+func (f *fakeNotifierHandler) SetQuietHours(convID string, quietHours config.QuietHours) error {
+	_, exists := f.conf[convID]
+	if !exists {
+		return ErrNotificationsNotConfigured
+	}
+
+	if f.quietHours == nil {
+		f.quietHours = map[string]config.QuietHours{}
+	}
+	f.quietHours[convID] = quietHours
+	return nil
+}
+
 func (f *fakeNotifierHandler) BotName() string {
 	return "fake"
 }
@@ -0,0 +1,594 @@
+package execute
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	batchV1 "k8s.io/api/batch/v1"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/kubectl"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	statusUsageMsg = "Please specify what to check. Use: status cluster"
+	statusNoneMsg  = "None."
+	// statusNotAllowedMsgFmt is returned when the conversation is not allowed to get any of the resources from the status snapshot.
+	statusNotAllowedMsgFmt = "Sorry, this channel is not allowed to get resources reported in the cluster status on cluster '%s'. Use 'commands list' to see allowed commands."
+	// statusForbiddenMsgFmt is reported in a section when BotKube itself is not allowed to list a given resource.
+	statusForbiddenMsgFmt = "BotKube is not allowed to list %s in this cluster. Check its RBAC permissions."
+	// statusMoreItemsFmt is reported at the end of a section which contains more than statusMaxItems objects.
+	statusMoreItemsFmt = "and %d more"
+
+	// statusVerb is the kubectl verb which has to be allowed to report a given resource in the status snapshot.
+	statusVerb = "get"
+
+	// statusRestartHotSpotThreshold defines the minimum number of container restarts for a Pod to be reported as a restart hot-spot.
+	statusRestartHotSpotThreshold = 5
+	// statusRecentEventsWindow defines how old warning Events are reported.
+	statusRecentEventsWindow = time.Hour
+	// statusMaxItems limits the number of reported objects and describe buttons per section.
+	statusMaxItems = 5
+)
+
+var (
+	nodesGVR  = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+	podsGVR   = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	pvcsGVR   = schema.GroupVersionResource{Version: "v1", Resource: "persistentvolumeclaims"}
+	eventsGVR = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+	jobsGVR   = schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "jobs"}
+)
+
+// StatusAction defines the actions available for the `status` command.
+type StatusAction string
+
+const (
+	// StatusCluster returns the cluster health snapshot.
+	StatusCluster StatusAction = "cluster"
+)
+
+// StatusExecutor builds the cluster health snapshot.
+type StatusExecutor struct {
+	log               logrus.FieldLogger
+	analyticsReporter AnalyticsReporter
+	kcChecker         *kubectl.Checker
+	merger            *kubectl.Merger
+	dynamicCli        dynamic.Interface
+	now               func() time.Time
+}
+
+// NewStatusExecutor creates a new instance of StatusExecutor.
+func NewStatusExecutor(log logrus.FieldLogger, analyticsReporter AnalyticsReporter, kcChecker *kubectl.Checker, merger *kubectl.Merger, dynamicCli dynamic.Interface) *StatusExecutor {
+	return &StatusExecutor{
+		log:               log,
+		analyticsReporter: analyticsReporter,
+		kcChecker:         kcChecker,
+		merger:            merger,
+		dynamicCli:        dynamicCli,
+		now:               time.Now,
+	}
+}
+
+// Do executes the status command.
+// Sections are rendered only for resources which the given conversation is allowed to get,
+// and they contain only objects from the allowed Namespaces.
+func (e *StatusExecutor) Do(ctx context.Context, args []string, platform config.CommPlatformIntegration, conversation Conversation, clusterName, botName string) (interactive.Message, error) {
+	if len(args) != 2 || args[1] != string(StatusCluster) {
+		return statusMessage(statusUsageMsg), nil
+	}
+
+	err := e.analyticsReporter.ReportCommand(platform, strings.Join(args, " "), conversation.IsButtonClickOrigin)
+	if err != nil {
+		e.log.Errorf("while reporting status command: %s", err.Error())
+	}
+
+	btnBuilder := interactive.NewButtonBuilder(botName)
+	access := &statusAccess{
+		kcChecker:    e.kcChecker,
+		merger:       e.merger,
+		conversation: conversation,
+		cache:        map[statusAccessKey]bool{},
+	}
+	msg := interactive.Message{
+		Base: interactive.Base{
+			Header:      "Cluster status",
+			Description: fmt.Sprintf("Health snapshot of the `%s` cluster", clusterName),
+		},
+	}
+
+	nodes, visible, err := listAllowed[coreV1.Node](ctx, e.dynamicCli, access, nodesGVR)
+	switch {
+	case err != nil:
+		section, err := e.listErrorSection("Nodes", nodesGVR, err)
+		if err != nil {
+			return interactive.Message{}, err
+		}
+		msg.Sections = append(msg.Sections, section)
+	case visible:
+		msg.Sections = append(msg.Sections, e.nodesSection(nodes, btnBuilder))
+	}
+
+	// pods are listed once and shared by both Pod sections
+	pods, visible, err := listAllowed[coreV1.Pod](ctx, e.dynamicCli, access, podsGVR)
+	switch {
+	case err != nil:
+		section, err := e.listErrorSection("Pods", podsGVR, err)
+		if err != nil {
+			return interactive.Message{}, err
+		}
+		msg.Sections = append(msg.Sections, section)
+	case visible:
+		msg.Sections = append(msg.Sections, e.notReadyPodsSection(pods, btnBuilder), e.restartHotSpotsSection(pods, btnBuilder))
+	}
+
+	pvcs, visible, err := listAllowed[coreV1.PersistentVolumeClaim](ctx, e.dynamicCli, access, pvcsGVR)
+	switch {
+	case err != nil:
+		section, err := e.listErrorSection("Pending persistent volume claims", pvcsGVR, err)
+		if err != nil {
+			return interactive.Message{}, err
+		}
+		msg.Sections = append(msg.Sections, section)
+	case visible:
+		msg.Sections = append(msg.Sections, e.pendingPVCsSection(pvcs, btnBuilder))
+	}
+
+	jobs, visible, err := listAllowed[batchV1.Job](ctx, e.dynamicCli, access, jobsGVR)
+	switch {
+	case err != nil:
+		section, err := e.listErrorSection("Failed jobs", jobsGVR, err)
+		if err != nil {
+			return interactive.Message{}, err
+		}
+		msg.Sections = append(msg.Sections, section)
+	case visible:
+		msg.Sections = append(msg.Sections, e.failedJobsSection(jobs, btnBuilder))
+	}
+
+	k8sEvents, visible, err := listAllowed[coreV1.Event](ctx, e.dynamicCli, access, eventsGVR)
+	switch {
+	case err != nil:
+		section, err := e.listErrorSection("Recent warning events", eventsGVR, err)
+		if err != nil {
+			return interactive.Message{}, err
+		}
+		msg.Sections = append(msg.Sections, section)
+	case visible:
+		msg.Sections = append(msg.Sections, e.warningEventsSection(k8sEvents, btnBuilder))
+	}
+
+	if len(msg.Sections) == 0 {
+		return statusMessage(fmt.Sprintf(statusNotAllowedMsgFmt, clusterName)), nil
+	}
+
+	return msg, nil
+}
+
+// nodesSection reports only nodes which are not ready or are under pressure.
+func (e *StatusExecutor) nodesSection(nodes []coreV1.Node, btnBuilder *interactive.ButtonBuilder) interactive.Section {
+	type unhealthyNode struct {
+		name     string
+		ready    bool
+		pressure []string
+	}
+	var (
+		unhealthy []unhealthyNode
+		notReady  int
+	)
+	for _, node := range nodes {
+		ready := false
+		var pressure []string
+		for _, cond := range node.Status.Conditions {
+			switch cond.Type {
+			case coreV1.NodeReady:
+				ready = cond.Status == coreV1.ConditionTrue
+			case coreV1.NodeMemoryPressure, coreV1.NodeDiskPressure, coreV1.NodePIDPressure:
+				if cond.Status == coreV1.ConditionTrue {
+					pressure = append(pressure, string(cond.Type))
+				}
+			}
+		}
+
+		if !ready {
+			notReady++
+		}
+		if ready && len(pressure) == 0 {
+			continue
+		}
+		unhealthy = append(unhealthy, unhealthyNode{name: node.Name, ready: ready, pressure: pressure})
+	}
+
+	section := interactive.Section{
+		Base: interactive.Base{
+			Header:      "Nodes",
+			Description: fmt.Sprintf("%d of %d nodes ready", len(nodes)-notReady, len(nodes)),
+		},
+	}
+	if len(unhealthy) == 0 {
+		section.Body.Plaintext = statusNoneMsg
+		return section
+	}
+
+	unhealthy, omitted := capItems(unhealthy)
+	section.Body.CodeBlock = statusTable(func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "NODE\tREADY\tPRESSURE")
+		for _, node := range unhealthy {
+			fmt.Fprintf(w, "%s\t%t\t%s\n", node.name, node.ready, joinOrDash(node.pressure))
+			section.Buttons = append(section.Buttons, btnBuilder.ForCommandWithDescCmd(fmt.Sprintf("Describe %s", node.name), fmt.Sprintf("describe node %s", node.name)))
+		}
+		writeMoreItems(w, omitted)
+	})
+
+	return section
+}
+
+func (e *StatusExecutor) notReadyPodsSection(pods []coreV1.Pod, btnBuilder *interactive.ButtonBuilder) interactive.Section {
+	perNamespace := map[string]int{}
+	var buttons interactive.Buttons
+	for _, pod := range pods {
+		if pod.Status.Phase == coreV1.PodSucceeded || isPodReady(pod) {
+			continue
+		}
+
+		perNamespace[pod.Namespace]++
+		if len(buttons) < statusMaxItems {
+			buttons = append(buttons, describeButton(btnBuilder, "pod", pod.Namespace, pod.Name))
+		}
+	}
+
+	section := interactive.Section{
+		Base: interactive.Base{
+			Header: "Pods not ready",
+		},
+		Buttons: buttons,
+	}
+	if len(perNamespace) == 0 {
+		section.Body.Plaintext = statusNoneMsg
+		return section
+	}
+
+	namespaces, omitted := capItems(sortedKeys(perNamespace))
+	section.Body.CodeBlock = statusTable(func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "NAMESPACE\tNOT READY")
+		for _, ns := range namespaces {
+			fmt.Fprintf(w, "%s\t%d\n", ns, perNamespace[ns])
+		}
+		writeMoreItems(w, omitted)
+	})
+
+	return section
+}
+
+func (e *StatusExecutor) restartHotSpotsSection(pods []coreV1.Pod, btnBuilder *interactive.ButtonBuilder) interactive.Section {
+	type hotSpot struct {
+		pod      coreV1.Pod
+		restarts int32
+	}
+	var hotSpots []hotSpot
+	for _, pod := range pods {
+		var restarts int32
+		for _, status := range pod.Status.ContainerStatuses {
+			restarts += status.RestartCount
+		}
+		if restarts < statusRestartHotSpotThreshold {
+			continue
+		}
+		hotSpots = append(hotSpots, hotSpot{pod: pod, restarts: restarts})
+	}
+
+	sort.SliceStable(hotSpots, func(i, j int) bool {
+		return hotSpots[i].restarts > hotSpots[j].restarts
+	})
+	hotSpots, omitted := capItems(hotSpots)
+
+	section := interactive.Section{
+		Base: interactive.Base{
+			Header: "Restart hot-spots",
+		},
+	}
+	if len(hotSpots) == 0 {
+		section.Body.Plaintext = statusNoneMsg
+		return section
+	}
+
+	section.Body.CodeBlock = statusTable(func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "POD\tRESTARTS")
+		for _, item := range hotSpots {
+			fmt.Fprintf(w, "%s/%s\t%d\n", item.pod.Namespace, item.pod.Name, item.restarts)
+			section.Buttons = append(section.Buttons, describeButton(btnBuilder, "pod", item.pod.Namespace, item.pod.Name))
+		}
+		writeMoreItems(w, omitted)
+	})
+
+	return section
+}
+
+func (e *StatusExecutor) pendingPVCsSection(pvcs []coreV1.PersistentVolumeClaim, btnBuilder *interactive.ButtonBuilder) interactive.Section {
+	var refs []string
+	section := interactive.Section{
+		Base: interactive.Base{
+			Header: "Pending persistent volume claims",
+		},
+	}
+	for _, pvc := range pvcs {
+		if pvc.Status.Phase != coreV1.ClaimPending {
+			continue
+		}
+		refs = append(refs, fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name))
+		if len(section.Buttons) < statusMaxItems {
+			section.Buttons = append(section.Buttons, describeButton(btnBuilder, "pvc", pvc.Namespace, pvc.Name))
+		}
+	}
+
+	section.Body = objectList(refs)
+	return section
+}
+
+func (e *StatusExecutor) failedJobsSection(jobs []batchV1.Job, btnBuilder *interactive.ButtonBuilder) interactive.Section {
+	var refs []string
+	section := interactive.Section{
+		Base: interactive.Base{
+			Header: "Failed jobs",
+		},
+	}
+	for _, job := range jobs {
+		failed := false
+		for _, cond := range job.Status.Conditions {
+			if cond.Type == batchV1.JobFailed && cond.Status == coreV1.ConditionTrue {
+				failed = true
+				break
+			}
+		}
+		if !failed {
+			continue
+		}
+
+		refs = append(refs, fmt.Sprintf("%s/%s", job.Namespace, job.Name))
+		if len(section.Buttons) < statusMaxItems {
+			section.Buttons = append(section.Buttons, describeButton(btnBuilder, "job", job.Namespace, job.Name))
+		}
+	}
+
+	section.Body = objectList(refs)
+	return section
+}
+
+func (e *StatusExecutor) warningEventsSection(k8sEvents []coreV1.Event, btnBuilder *interactive.ButtonBuilder) interactive.Section {
+	since := e.now().Add(-statusRecentEventsWindow)
+	var recent []coreV1.Event
+	for _, event := range k8sEvents {
+		if event.Type != coreV1.EventTypeWarning || eventTime(event).Before(since) {
+			continue
+		}
+		recent = append(recent, event)
+	}
+
+	sort.SliceStable(recent, func(i, j int) bool {
+		return eventTime(recent[i]).After(eventTime(recent[j]))
+	})
+	recent, omitted := capItems(recent)
+
+	section := interactive.Section{
+		Base: interactive.Base{
+			Header:      "Recent warning events",
+			Description: fmt.Sprintf("Warning events from the last %s", statusRecentEventsWindow),
+		},
+	}
+	if len(recent) == 0 {
+		section.Body.Plaintext = statusNoneMsg
+		return section
+	}
+
+	section.Body.CodeBlock = statusTable(func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "OBJECT\tREASON\tCOUNT")
+		for _, event := range recent {
+			obj := event.InvolvedObject
+			fmt.Fprintf(w, "%s %s\t%s\t%d\n", obj.Kind, objectRef(obj.Namespace, obj.Name), event.Reason, event.Count)
+			section.Buttons = append(section.Buttons, describeButton(btnBuilder, strings.ToLower(obj.Kind), obj.Namespace, obj.Name))
+		}
+		writeMoreItems(w, omitted)
+	})
+
+	return section
+}
+
+// listErrorSection returns a section which reports that BotKube is not allowed to list a given resource.
+// Other errors are returned, as they fail the whole command.
+func (e *StatusExecutor) listErrorSection(header string, gvr schema.GroupVersionResource, err error) (interactive.Section, error) {
+	if !apierrors.IsForbidden(err) {
+		return interactive.Section{}, fmt.Errorf("while listing %s: %w", gvr.Resource, err)
+	}
+
+	e.log.Warnf("while listing %s for cluster status: %s", gvr.Resource, err.Error())
+	return interactive.Section{
+		Base: interactive.Base{
+			Header: header,
+			Body: interactive.Body{
+				Plaintext: fmt.Sprintf(statusForbiddenMsgFmt, gvr.Resource),
+			},
+		},
+	}, nil
+}
+
+// statusAccessKey identifies a resource in a given Namespace.
+type statusAccessKey struct {
+	namespace string
+	resource  string
+}
+
+// statusAccess checks if a given conversation is allowed to get resources reported in the status snapshot.
+// Results are memoized, as the same Namespaces are checked for each listed object.
+type statusAccess struct {
+	kcChecker    *kubectl.Checker
+	merger       *kubectl.Merger
+	conversation Conversation
+	cache        map[statusAccessKey]bool
+}
+
+// mayBeAllowed returns true if the conversation might be allowed to get a given resource in at least one Namespace.
+// It doesn't take Namespaces into account, so objects still need to be checked with isAllowed.
+func (a *statusAccess) mayBeAllowed(resource string) bool {
+	kcConfig := a.merger.MergeAllEnabled(a.conversation.ExecutorBindings)
+	return a.kcChecker.IsVerbAllowedInNs(kcConfig, statusVerb) &&
+		a.kcChecker.IsResourceAllowedInNs(kcConfig, resource)
+}
+
+func (a *statusAccess) isAllowed(namespace, resource string) bool {
+	key := statusAccessKey{namespace: namespace, resource: resource}
+	if allowed, found := a.cache[key]; found {
+		return allowed
+	}
+
+	kcConfig := a.merger.MergeForNamespace(a.conversation.ExecutorBindings, namespace)
+	allowed := (a.conversation.IsAuthenticated || !kcConfig.RestrictAccess) &&
+		a.kcChecker.IsVerbAllowedInNs(kcConfig, statusVerb) &&
+		a.kcChecker.IsResourceAllowedInNs(kcConfig, resource)
+
+	a.cache[key] = allowed
+	return allowed
+}
+
+// listAllowed lists objects of a given resource from all Namespaces and transforms them into a given type.
+// Only objects from Namespaces where the conversation is allowed to get a given resource are returned.
+// The returned flag reports whether the conversation is allowed to get the resource in at least one Namespace.
+// Resources which the conversation is not allowed to get in any Namespace are not listed at all.
+func listAllowed[T any](ctx context.Context, dynamicCli dynamic.Interface, access *statusAccess, gvr schema.GroupVersionResource) ([]T, bool, error) {
+	if !access.mayBeAllowed(gvr.Resource) {
+		return nil, false, nil
+	}
+
+	list, err := dynamicCli.Resource(gvr).Namespace(metaV1.NamespaceAll).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	allowedInAllNs := access.isAllowed(config.AllNamespaceIndicator, gvr.Resource)
+	visible := allowedInAllNs
+
+	out := make([]T, 0, len(list.Items))
+	for i := range list.Items {
+		if !allowedInAllNs && !access.isAllowed(list.Items[i].GetNamespace(), gvr.Resource) {
+			continue
+		}
+		visible = true
+
+		var item T
+		err := utils.TransformIntoTypedObject(&list.Items[i], &item)
+		if err != nil {
+			return nil, false, fmt.Errorf("while transforming object type %T into type: %T: %w", list.Items[i], item, err)
+		}
+		out = append(out, item)
+	}
+
+	return out, visible, nil
+}
+
+func describeButton(btnBuilder *interactive.ButtonBuilder, kind, namespace, name string) interactive.Button {
+	cmd := fmt.Sprintf("describe %s %s", kind, name)
+	if namespace != "" {
+		cmd = fmt.Sprintf("%s -n %s", cmd, namespace)
+	}
+	return btnBuilder.ForCommandWithDescCmd(fmt.Sprintf("Describe %s", objectRef(namespace, name)), cmd)
+}
+
+func isPodReady(pod coreV1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == coreV1.PodReady {
+			return cond.Status == coreV1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func eventTime(event coreV1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+func objectList(refs []string) interactive.Body {
+	if len(refs) == 0 {
+		return interactive.Body{Plaintext: statusNoneMsg}
+	}
+
+	refs, omitted := capItems(refs)
+	if omitted > 0 {
+		refs = append(refs, fmt.Sprintf(statusMoreItemsFmt, omitted))
+	}
+	return interactive.Body{CodeBlock: strings.Join(refs, "\n")}
+}
+
+// capItems returns at most statusMaxItems first items and the number of omitted ones.
+func capItems[T any](items []T) ([]T, int) {
+	if len(items) <= statusMaxItems {
+		return items, 0
+	}
+	return items[:statusMaxItems], len(items) - statusMaxItems
+}
+
+func writeMoreItems(w io.Writer, omitted int) {
+	if omitted == 0 {
+		return
+	}
+	fmt.Fprintf(w, statusMoreItemsFmt+"\n", omitted)
+}
+
+func objectRef(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+func joinOrDash(in []string) string {
+	if len(in) == 0 {
+		return "-"
+	}
+	return strings.Join(in, ",")
+}
+
+func sortedKeys(in map[string]int) []string {
+	out := make([]string, 0, len(in))
+	for key := range in {
+		out = append(out, key)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func statusTable(fn func(w *tabwriter.Writer)) string {
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fn(w)
+	w.Flush()
+	return buf.String()
+}
+
+func statusMessage(msg string) interactive.Message {
+	return interactive.Message{
+		Base: interactive.Base{
+			Body: interactive.Body{
+				CodeBlock: msg,
+			},
+		},
+	}
+}
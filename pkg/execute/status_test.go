@@ -0,0 +1,410 @@
+package execute
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/execute/kubectl"
+	"github.com/kubeshop/botkube/pkg/ptr"
+)
+
+func TestStatusExecutor_Do(t *testing.T) {
+	// given
+	now := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixStatusObjects(now)...)
+
+	executor := fixStatusExecutor(t, dynamicCli, fixStatusKubectlCfg())
+	executor.now = func() time.Time { return now }
+
+	btnBuilder := interactive.NewButtonBuilder("@Botkube")
+	expSections := []interactive.Section{
+		{
+			Base: interactive.Base{
+				Header:      "Nodes",
+				Description: "1 of 2 nodes ready",
+				Body: interactive.Body{
+					CodeBlock: heredoc.Doc(`
+						NODE   READY PRESSURE
+						node-b false MemoryPressure,DiskPressure`),
+				},
+			},
+			Buttons: interactive.Buttons{
+				btnBuilder.ForCommandWithDescCmd("Describe node-b", "describe node node-b"),
+			},
+		},
+		{
+			Base: interactive.Base{
+				Header: "Pods not ready",
+				Body: interactive.Body{
+					CodeBlock: heredoc.Doc(`
+						NAMESPACE NOT READY
+						team-a    1`),
+				},
+			},
+			Buttons: interactive.Buttons{
+				btnBuilder.ForCommandWithDescCmd("Describe team-a/crashing", "describe pod crashing -n team-a"),
+			},
+		},
+		{
+			Base: interactive.Base{
+				Header: "Restart hot-spots",
+				Body: interactive.Body{
+					CodeBlock: heredoc.Doc(`
+						POD             RESTARTS
+						team-a/crashing 7`),
+				},
+			},
+			Buttons: interactive.Buttons{
+				btnBuilder.ForCommandWithDescCmd("Describe team-a/crashing", "describe pod crashing -n team-a"),
+			},
+		},
+		{
+			Base: interactive.Base{
+				Header: "Pending persistent volume claims",
+				Body: interactive.Body{
+					CodeBlock: "team-b/data",
+				},
+			},
+			Buttons: interactive.Buttons{
+				btnBuilder.ForCommandWithDescCmd("Describe team-b/data", "describe pvc data -n team-b"),
+			},
+		},
+		{
+			Base: interactive.Base{
+				Header: "Failed jobs",
+				Body: interactive.Body{
+					Plaintext: "None.",
+				},
+			},
+		},
+		{
+			Base: interactive.Base{
+				Header:      "Recent warning events",
+				Description: "Warning events from the last 1h0m0s",
+				Body: interactive.Body{
+					CodeBlock: heredoc.Doc(`
+						OBJECT              REASON  COUNT
+						Pod team-a/crashing BackOff 3`),
+				},
+			},
+			Buttons: interactive.Buttons{
+				btnBuilder.ForCommandWithDescCmd("Describe team-a/crashing", "describe pod crashing -n team-a"),
+			},
+		},
+	}
+
+	// when
+	msg, err := executor.Do(context.Background(), []string{"status", "cluster"}, config.SocketSlackCommPlatformIntegration, Conversation{IsAuthenticated: true, ExecutorBindings: []string{"default"}}, "test", "@Botkube")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Cluster status", msg.Header)
+	assert.Equal(t, "Health snapshot of the `test` cluster", msg.Description)
+	require.Len(t, msg.Sections, len(expSections))
+	for i, exp := range expSections {
+		got := msg.Sections[i]
+		got.Body.CodeBlock = strings.TrimSuffix(got.Body.CodeBlock, "\n")
+		assert.Equal(t, exp, got)
+	}
+}
+
+func TestStatusExecutor_DoFailedJob(t *testing.T) {
+	// given
+	job := &batchv1.Job{
+		TypeMeta:   metav1.TypeMeta{Kind: "Job", APIVersion: "batch/v1"},
+		ObjectMeta: metav1.ObjectMeta{Name: "migrate", Namespace: "team-a"},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: v1.ConditionTrue},
+			},
+		},
+	}
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, job)
+
+	executor := fixStatusExecutor(t, dynamicCli, fixStatusKubectlCfg())
+
+	// when
+	msg, err := executor.Do(context.Background(), []string{"status", "cluster"}, config.SocketSlackCommPlatformIntegration, Conversation{IsAuthenticated: true, ExecutorBindings: []string{"default"}}, "test", "@Botkube")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, msg.Sections, 6)
+
+	failedJobs := msg.Sections[4]
+	assert.Equal(t, "Failed jobs", failedJobs.Header)
+	assert.Equal(t, "team-a/migrate", failedJobs.Body.CodeBlock)
+	assert.Equal(t, interactive.Buttons{
+		interactive.NewButtonBuilder("@Botkube").ForCommandWithDescCmd("Describe team-a/migrate", "describe job migrate -n team-a"),
+	}, failedJobs.Buttons)
+}
+
+func TestStatusExecutor_DoCapsSections(t *testing.T) {
+	// given
+	var objs []runtime.Object
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		objs = append(objs,
+			&v1.Node{
+				TypeMeta:   metav1.TypeMeta{Kind: "Node", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "node-" + name},
+			},
+			&v1.PersistentVolumeClaim{
+				TypeMeta:   metav1.TypeMeta{Kind: "PersistentVolumeClaim", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: "data-" + name, Namespace: "team-a"},
+				Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+			},
+		)
+	}
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, objs...)
+
+	executor := fixStatusExecutor(t, dynamicCli, fixStatusKubectlCfg())
+
+	// when
+	msg, err := executor.Do(context.Background(), []string{"status", "cluster"}, config.SocketSlackCommPlatformIntegration, Conversation{IsAuthenticated: true, ExecutorBindings: []string{"default"}}, "test", "@Botkube")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, msg.Sections, 6)
+
+	nodes := msg.Sections[0]
+	assert.Equal(t, "0 of 7 nodes ready", nodes.Description)
+	assert.Equal(t, heredoc.Doc(`
+		NODE   READY PRESSURE
+		node-a false -
+		node-b false -
+		node-c false -
+		node-d false -
+		node-e false -
+		and 2 more
+	`), nodes.Body.CodeBlock)
+	assert.Len(t, nodes.Buttons, 5)
+
+	pvcs := msg.Sections[3]
+	assert.Equal(t, heredoc.Doc(`
+		team-a/data-a
+		team-a/data-b
+		team-a/data-c
+		team-a/data-d
+		team-a/data-e
+		and 2 more`), pvcs.Body.CodeBlock)
+	assert.Len(t, pvcs.Buttons, 5)
+}
+
+func TestStatusExecutor_DoForbiddenResource(t *testing.T) {
+	// given
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixStatusObjects(time.Now())...)
+	dynamicCli.PrependReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "nodes"}, "", errors.New("missing RBAC"))
+	})
+
+	executor := fixStatusExecutor(t, dynamicCli, fixStatusKubectlCfg())
+
+	// when
+	msg, err := executor.Do(context.Background(), []string{"status", "cluster"}, config.SocketSlackCommPlatformIntegration, Conversation{IsAuthenticated: true, ExecutorBindings: []string{"default"}}, "test", "@Botkube")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, msg.Sections, 6)
+	assert.Equal(t, "Nodes", msg.Sections[0].Header)
+	assert.Equal(t, "BotKube is not allowed to list nodes in this cluster. Check its RBAC permissions.", msg.Sections[0].Body.Plaintext)
+	assert.Equal(t, "Pods not ready", msg.Sections[1].Header)
+}
+
+func TestStatusExecutor_DoUnknownAction(t *testing.T) {
+	// given
+	executor := fixStatusExecutor(t, fake.NewSimpleDynamicClient(scheme.Scheme), fixStatusKubectlCfg())
+
+	// when
+	msg, err := executor.Do(context.Background(), []string{"status", "nodes"}, config.SocketSlackCommPlatformIntegration, Conversation{IsAuthenticated: true, ExecutorBindings: []string{"default"}}, "test", "@Botkube")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Please specify what to check. Use: status cluster", msg.Body.CodeBlock)
+	assert.Empty(t, msg.Sections)
+}
+
+func TestStatusExecutor_DoRestrictedChannel(t *testing.T) {
+	// given
+	now := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixStatusObjects(now)...)
+
+	kubectlCfg := fixStatusKubectlCfg()
+	kubectlCfg.Namespaces.Include = []string{"team-b"}
+	kubectlCfg.Commands.Resources = []string{"pods", "persistentvolumeclaims"}
+
+	executor := fixStatusExecutor(t, dynamicCli, kubectlCfg)
+	executor.now = func() time.Time { return now }
+
+	// when
+	msg, err := executor.Do(context.Background(), []string{"status", "cluster"}, config.SocketSlackCommPlatformIntegration, Conversation{IsAuthenticated: true, ExecutorBindings: []string{"default"}}, "test", "@Botkube")
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, msg.Sections, 3)
+
+	// the crashing Pod from the 'team-a' Namespace is not reported
+	assert.Equal(t, "Pods not ready", msg.Sections[0].Header)
+	assert.Equal(t, "None.", msg.Sections[0].Body.Plaintext)
+	assert.Equal(t, "Restart hot-spots", msg.Sections[1].Header)
+	assert.Equal(t, "None.", msg.Sections[1].Body.Plaintext)
+	assert.Equal(t, "Pending persistent volume claims", msg.Sections[2].Header)
+	assert.Equal(t, "team-b/data", msg.Sections[2].Body.CodeBlock)
+
+	// resources which are not allowed in any Namespace are not listed
+	var listed []string
+	for _, action := range dynamicCli.Actions() {
+		listed = append(listed, action.GetResource().Resource)
+	}
+	assert.ElementsMatch(t, []string{"pods", "persistentvolumeclaims"}, listed)
+}
+
+func TestStatusExecutor_DoRestrictedAccess(t *testing.T) {
+	// given
+	kubectlCfg := fixStatusKubectlCfg()
+	kubectlCfg.RestrictAccess = ptr.Bool(true)
+
+	executor := fixStatusExecutor(t, fake.NewSimpleDynamicClient(scheme.Scheme, fixStatusObjects(time.Now())...), kubectlCfg)
+
+	// when
+	msg, err := executor.Do(context.Background(), []string{"status", "cluster"}, config.SocketSlackCommPlatformIntegration, Conversation{IsAuthenticated: false, ExecutorBindings: []string{"default"}}, "test", "@Botkube")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "Sorry, this channel is not allowed to get resources reported in the cluster status on cluster 'test'. Use 'commands list' to see allowed commands.", msg.Body.CodeBlock)
+	assert.Empty(t, msg.Sections)
+}
+
+func fixStatusExecutor(t *testing.T, dynamicCli dynamic.Interface, kubectlCfg config.Kubectl) *StatusExecutor {
+	t.Helper()
+
+	logger, _ := logtest.NewNullLogger()
+	cfg := fixCfgWithKubectlExecutor(t, kubectlCfg)
+	return NewStatusExecutor(logger, &fakeAnalyticsReporter{}, kubectl.NewChecker(nil), kubectl.NewMerger(cfg.Executors), dynamicCli)
+}
+
+func fixStatusKubectlCfg() config.Kubectl {
+	return config.Kubectl{
+		Enabled: true,
+		Namespaces: config.Namespaces{
+			Include: []string{config.AllNamespaceIndicator},
+		},
+		Commands: config.Commands{
+			Verbs:     []string{"get"},
+			Resources: []string{"nodes", "pods", "persistentvolumeclaims", "jobs", "events"},
+		},
+	}
+}
+
+func fixStatusObjects(now time.Time) []runtime.Object {
+	return []runtime.Object{
+		&v1.Node{
+			TypeMeta:   metav1.TypeMeta{Kind: "Node", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{
+					{Type: v1.NodeReady, Status: v1.ConditionTrue},
+					{Type: v1.NodeMemoryPressure, Status: v1.ConditionFalse},
+				},
+			},
+		},
+		&v1.Node{
+			TypeMeta:   metav1.TypeMeta{Kind: "Node", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "node-b"},
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{
+					{Type: v1.NodeReady, Status: v1.ConditionFalse},
+					{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue},
+					{Type: v1.NodeDiskPressure, Status: v1.ConditionTrue},
+				},
+			},
+		},
+		&v1.Pod{
+			TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "healthy", Namespace: "team-a"},
+			Status: v1.PodStatus{
+				Phase:             v1.PodRunning,
+				Conditions:        []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionTrue}},
+				ContainerStatuses: []v1.ContainerStatus{{Name: "app", RestartCount: 1}},
+			},
+		},
+		&v1.Pod{
+			TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "crashing", Namespace: "team-a"},
+			Status: v1.PodStatus{
+				Phase:             v1.PodRunning,
+				Conditions:        []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}},
+				ContainerStatuses: []v1.ContainerStatus{{Name: "app", RestartCount: 7}},
+			},
+		},
+		&v1.Pod{
+			TypeMeta:   metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "completed", Namespace: "team-b"},
+			Status: v1.PodStatus{
+				Phase: v1.PodSucceeded,
+			},
+		},
+		&v1.PersistentVolumeClaim{
+			TypeMeta:   metav1.TypeMeta{Kind: "PersistentVolumeClaim", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "team-b"},
+			Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending},
+		},
+		&v1.PersistentVolumeClaim{
+			TypeMeta:   metav1.TypeMeta{Kind: "PersistentVolumeClaim", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "bound", Namespace: "team-b"},
+			Status:     v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound},
+		},
+		&batchv1.Job{
+			TypeMeta:   metav1.TypeMeta{Kind: "Job", APIVersion: "batch/v1"},
+			ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "team-b"},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: v1.ConditionTrue}},
+			},
+		},
+		&v1.Event{
+			TypeMeta:       metav1.TypeMeta{Kind: "Event", APIVersion: "v1"},
+			ObjectMeta:     metav1.ObjectMeta{Name: "crashing.1", Namespace: "team-a"},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "crashing", Namespace: "team-a"},
+			Type:           v1.EventTypeWarning,
+			Reason:         "BackOff",
+			Count:          3,
+			LastTimestamp:  metav1.NewTime(now.Add(-10 * time.Minute)),
+		},
+		&v1.Event{
+			TypeMeta:       metav1.TypeMeta{Kind: "Event", APIVersion: "v1"},
+			ObjectMeta:     metav1.ObjectMeta{Name: "crashing.2", Namespace: "team-a"},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "crashing", Namespace: "team-a"},
+			Type:           v1.EventTypeWarning,
+			Reason:         "FailedMount",
+			Count:          1,
+			LastTimestamp:  metav1.NewTime(now.Add(-2 * time.Hour)),
+		},
+		&v1.Event{
+			TypeMeta:       metav1.TypeMeta{Kind: "Event", APIVersion: "v1"},
+			ObjectMeta:     metav1.ObjectMeta{Name: "healthy.1", Namespace: "team-a"},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "healthy", Namespace: "team-a"},
+			Type:           v1.EventTypeNormal,
+			Reason:         "Started",
+			Count:          1,
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+	}
+}
@@ -23,3 +23,11 @@ func AdaptiveCodeBlock(msg string) string {
 	}
 	return code(strings.TrimSpace(msg))
 }
+
+// OptionalCodeBlock wraps a message in a code block. If message is empty, it returns empty string.
+func OptionalCodeBlock(msg string) string {
+	if strings.TrimSpace(msg) == "" {
+		return ""
+	}
+	return CodeBlock(msg)
+}
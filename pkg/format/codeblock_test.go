@@ -54,3 +54,31 @@ func TestAdaptiveCodeBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionalCodeBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		expected string
+	}{
+		{
+			name:     "Non-empty string",
+			in:       "panic: missing config\n",
+			expected: "```\npanic: missing config\n```",
+		},
+		{
+			name:     "Whitespace only",
+			in:       " \n\t",
+			expected: "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := format.OptionalCodeBlock(tc.in)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
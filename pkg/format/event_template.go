@@ -0,0 +1,76 @@
+package format
+
+import (
+	"bytes"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/format/eventtemplate"
+)
+
+// EventTemplateData holds data available in custom event templates.
+type EventTemplateData struct {
+	events.Event
+
+	// Object is the raw Kubernetes object, so its fields can be selected, e.g. `{{ .Object.spec.replicas }}`.
+	Object map[string]interface{}
+}
+
+// RenderEventTemplate renders title and body of a given event with a custom template.
+// If the title or body template is not specified, the default one is used.
+func RenderEventTemplate(tpl config.EventTemplate, event events.Event) (string, string, error) {
+	obj, err := objectToMap(event.Object)
+	if err != nil {
+		return "", "", fmt.Errorf("while converting object: %w", err)
+	}
+	data := EventTemplateData{Event: event, Object: obj}
+
+	title := event.Title
+	if tpl.Title != "" {
+		title, err = renderTemplate("title", tpl.Title, data)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	body := ShortMessage(event)
+	if tpl.Body != "" {
+		body, err = renderTemplate("body", tpl.Body, data)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	return title, body, nil
+}
+
+func renderTemplate(name, text string, data EventTemplateData) (string, error) {
+	tpl, err := eventtemplate.Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("while parsing %s template: %w", name, err)
+	}
+
+	var out bytes.Buffer
+	if err := tpl.Execute(&out, data); err != nil {
+		return "", fmt.Errorf("while rendering %s template: %w", name, err)
+	}
+
+	return out.String(), nil
+}
+
+func objectToMap(obj interface{}) (map[string]interface{}, error) {
+	switch in := obj.(type) {
+	case nil:
+		return nil, nil
+	case *unstructured.Unstructured:
+		return in.Object, nil
+	case map[string]interface{}:
+		return in, nil
+	default:
+		return runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	}
+}
@@ -0,0 +1,98 @@
+package format_test
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/format"
+)
+
+func TestRenderEventTemplate(t *testing.T) {
+	// given
+	event := events.Event{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		Title:           "v1/deployments updated",
+		Name:            "api",
+		Namespace:       "prod",
+		Type:            config.UpdateEvent,
+		Cluster:         "cluster-name",
+		Recommendations: []string{"recommendation 1", "recommendation 2"},
+		Object: &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		Name          string
+		Template      config.EventTemplate
+		ExpectedTitle string
+		ExpectedBody  string
+	}{
+		{
+			Name: "Title and body",
+			Template: config.EventTemplate{
+				Title: "{{ .Kind | upper }} {{ .Namespace }}/{{ .Name }}",
+				Body: heredoc.Doc(`
+					Replicas: {{ .Object.spec.replicas }}
+					{{ bullets .Recommendations }}`),
+			},
+			ExpectedTitle: "DEPLOYMENT prod/api",
+			ExpectedBody: heredoc.Doc(`
+				Replicas: 3
+				- recommendation 1
+				- recommendation 2
+			`),
+		},
+		{
+			Name: "Title only",
+			Template: config.EventTemplate{
+				Title: "{{ .Name }} on {{ .Cluster }}",
+			},
+			ExpectedTitle: "api on cluster-name",
+			ExpectedBody:  format.ShortMessage(event),
+		},
+		{
+			Name: "Body only",
+			Template: config.EventTemplate{
+				Body: `{{ join ", " .Recommendations }}`,
+			},
+			ExpectedTitle: "v1/deployments updated",
+			ExpectedBody:  "recommendation 1, recommendation 2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			// when
+			title, body, err := format.RenderEventTemplate(tc.Template, event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedTitle, title)
+			assert.Equal(t, tc.ExpectedBody, body)
+		})
+	}
+}
+
+func TestRenderEventTemplate_Invalid(t *testing.T) {
+	// when
+	_, _, err := format.RenderEventTemplate(config.EventTemplate{Body: "{{ .Name "}, events.Event{})
+
+	// then
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "while parsing body template")
+}
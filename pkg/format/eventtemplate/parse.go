@@ -0,0 +1,51 @@
+package eventtemplate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+const bulletPointFmt = "- %s\n"
+
+var funcs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join": func(sep string, in []string) string {
+		return strings.Join(in, sep)
+	},
+	"bullets": func(in []string) string {
+		var out strings.Builder
+		for _, item := range in {
+			out.WriteString(fmt.Sprintf(bulletPointFmt, item))
+		}
+		return out.String()
+	},
+}
+
+var (
+	parsedMu sync.RWMutex
+	parsed   = map[string]*template.Template{}
+)
+
+// Parse parses a given event template text. Parsed templates are cached, so each template is parsed only once,
+// usually while validating the configuration.
+func Parse(text string) (*template.Template, error) {
+	parsedMu.RLock()
+	tpl, found := parsed[text]
+	parsedMu.RUnlock()
+	if found {
+		return tpl, nil
+	}
+
+	tpl, err := template.New("event").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedMu.Lock()
+	defer parsedMu.Unlock()
+	parsed[text] = tpl
+	return tpl, nil
+}
@@ -0,0 +1,27 @@
+package eventtemplate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_CachesTemplates(t *testing.T) {
+	// when
+	first, err := Parse("{{ .Name | upper }}")
+	require.NoError(t, err)
+	second, err := Parse("{{ .Name | upper }}")
+	require.NoError(t, err)
+
+	// then
+	assert.Same(t, first, second)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	// when
+	_, err := Parse("{{ .Name ")
+
+	// then
+	assert.Error(t, err)
+}
@@ -24,37 +24,45 @@ func messageHeader(event events.Event) string {
 		resourceName = fmt.Sprintf("%s/%s", event.Namespace, event.Name)
 	}
 
+	subject := fmt.Sprintf("%s *%s*", event.Kind, resourceName)
+	if event.Workload() != "" {
+		subject = fmt.Sprintf("%s (%s *%s*)", subject, event.WorkloadKind, event.WorkloadName)
+	}
+
 	switch event.Type {
 	case config.CreateEvent, config.DeleteEvent, config.UpdateEvent:
 		return fmt.Sprintf(
-			"%s *%s* has been %s in *%s* cluster",
-			event.Kind,
-			resourceName,
+			"%s has been %s in *%s* cluster",
+			subject,
 			event.Type+"d",
 			event.Cluster,
 		)
 
 	case config.ErrorEvent:
 		return fmt.Sprintf(
-			"Error occurred for %s *%s* in *%s* cluster",
-			event.Kind,
-			resourceName,
+			"Error occurred for %s in *%s* cluster",
+			subject,
+			event.Cluster,
+		)
+
+	case config.ResolvedEvent:
+		return fmt.Sprintf(
+			"Error resolved for %s in *%s* cluster",
+			subject,
 			event.Cluster,
 		)
 
 	case config.WarningEvent:
 		return fmt.Sprintf(
-			"Warning for %s *%s* in *%s* cluster",
-			event.Kind,
-			resourceName,
+			"Warning for %s in *%s* cluster",
+			subject,
 			event.Cluster,
 		)
 
 	case config.InfoEvent, config.NormalEvent:
 		return fmt.Sprintf(
-			"Info for %s *%s* in *%s* cluster",
-			event.Kind,
-			resourceName,
+			"Info for %s in *%s* cluster",
+			subject,
 			event.Cluster,
 		)
 	}
@@ -67,6 +75,9 @@ func messageAttachments(event events.Event) string {
 	if len(event.Messages) > 0 {
 		additionalMsgStrBuilder.WriteString(JoinMessages(event.Messages))
 	}
+	if event.Related() != "" {
+		additionalMsgStrBuilder.WriteString(fmt.Sprintf("Related: %s\n", event.Related()))
+	}
 	if len(event.Recommendations) > 0 {
 		additionalMsgStrBuilder.WriteString("Recommendations:\n")
 
@@ -81,6 +92,14 @@ func messageAttachments(event events.Event) string {
 			additionalMsgStrBuilder.WriteString(fmt.Sprintf(bulletPointFmt, m))
 		}
 	}
+	if event.Diff != "" {
+		additionalMsgStrBuilder.WriteString("Diff:\n")
+		additionalMsgStrBuilder.WriteString(fmt.Sprintf("%s\n", event.Diff))
+	}
+	if event.Logs != "" {
+		additionalMsgStrBuilder.WriteString("Logs:\n")
+		additionalMsgStrBuilder.WriteString(fmt.Sprintf("%s\n", event.Logs))
+	}
 
 	if additionalMsgStrBuilder.Len() == 0 {
 		return ""
@@ -164,6 +164,101 @@ func TestShortMessage(t *testing.T) {
 			},
 			Expected: fmt.Sprintf("Info for Pod *namespace/pod* in *cluster-name* cluster\n%s", expectedAttachments),
 		},
+		{
+			Name: "Resolved event for namespaced resource",
+			Input: events.Event{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Certificate",
+					APIVersion: "cert-manager.io/v1",
+				},
+				Name:            "api-tls",
+				Namespace:       "namespace",
+				Messages:        []string{"message 1", "message 2"},
+				Type:            config.ResolvedEvent,
+				Cluster:         "cluster-name",
+				Recommendations: []string{"recommendation 1", "recommendation 2"},
+				Warnings:        []string{"warning 1", "warning 2"},
+			},
+			Expected: fmt.Sprintf("Error resolved for Certificate *namespace/api-tls* in *cluster-name* cluster\n%s", expectedAttachments),
+		},
+		{
+			Name: "Error event for resource owned by workload",
+			Input: events.Event{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pod",
+					APIVersion: "v1",
+				},
+				Name:            "api-7d9f8-xk2lp",
+				Namespace:       "namespace",
+				Messages:        []string{"message 1", "message 2"},
+				Type:            config.ErrorEvent,
+				Cluster:         "cluster-name",
+				Recommendations: []string{"recommendation 1", "recommendation 2"},
+				Warnings:        []string{"warning 1", "warning 2"},
+				WorkloadKind:    "Deployment",
+				WorkloadName:    "api",
+			},
+			Expected: fmt.Sprintf("Error occurred for Pod *namespace/api-7d9f8-xk2lp* (Deployment *api*) in *cluster-name* cluster\n%s", expectedAttachments),
+		},
+		{
+			Name: "Error event with logs",
+			Input: events.Event{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pod",
+					APIVersion: "v1",
+				},
+				Name:      "pod",
+				Namespace: "namespace",
+				Messages:  []string{"Back-off restarting failed container"},
+				Type:      config.ErrorEvent,
+				Cluster:   "cluster-name",
+				Logs:      "starting server\npanic: missing config",
+			},
+			Expected: "Error occurred for Pod *namespace/pod* in *cluster-name* cluster\n```\n" +
+				heredoc.Doc(`
+					Back-off restarting failed container
+					Logs:
+					starting server
+					panic: missing config
+				`) + "```",
+		},
+		{
+			Name: "Update event with diff",
+			Input: events.Event{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Deployment",
+					APIVersion: "apps/v1",
+				},
+				Name:      "api",
+				Namespace: "namespace",
+				Type:      config.UpdateEvent,
+				Cluster:   "cluster-name",
+				Diff:      "spec.replicas:\n\t-: 1\n\t+: 3",
+			},
+			Expected: "Deployment *namespace/api* has been updated in *cluster-name* cluster\n```\n" +
+				"Diff:\nspec.replicas:\n\t-: 1\n\t+: 3\n" + "```",
+		},
+		{
+			Name: "Warning event with related object",
+			Input: events.Event{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "Pod",
+					APIVersion: "v1",
+				},
+				Name:        "pod",
+				Namespace:   "namespace",
+				Messages:    []string{"Multi-Attach error for volume"},
+				Type:        config.WarningEvent,
+				Cluster:     "cluster-name",
+				RelatedKind: "Node",
+				RelatedName: "node-1",
+			},
+			Expected: "Warning for Pod *namespace/pod* in *cluster-name* cluster\n```\n" +
+				heredoc.Doc(`
+					Multi-Attach error for volume
+					Related: Node/node-1
+				`) + "```",
+		},
 	}
 
 	for _, tc := range testCases {
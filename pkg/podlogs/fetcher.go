@@ -0,0 +1,166 @@
+package podlogs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const (
+	// DefaultTailLines defines the number of log lines attached if not specified in configuration.
+	DefaultTailLines int64 = 20
+	// DefaultMaxBytes defines the maximum size of attached logs if not specified in configuration.
+	DefaultMaxBytes int64 = 16 * 1024
+
+	podKind                = "Pod"
+	crashLoopBackOffReason = "CrashLoopBackOff"
+)
+
+// logsReasons contains the reasons of the Pod error events which have the container logs attached.
+// Other errors, such as FailedMount or ErrImagePull, are reported before the container starts, so there are no logs to fetch.
+var logsReasons = map[string]struct{}{
+	"BackOff":              {},
+	crashLoopBackOffReason: {},
+	"OOMKilled":            {},
+}
+
+// containerFieldPathRegex matches the container reference of the Kubernetes Event, e.g. `spec.containers{app}`.
+var containerFieldPathRegex = regexp.MustCompile(`^spec\.(?:initContainers|containers|ephemeralContainers)\{(.+)\}$`)
+
+// Fetcher attaches the logs of the failing container to Pod error events.
+type Fetcher struct {
+	log    logrus.FieldLogger
+	k8sCli kubernetes.Interface
+}
+
+// NewFetcher returns a new Fetcher instance.
+func NewFetcher(log logrus.FieldLogger, k8sCli kubernetes.Interface) *Fetcher {
+	return &Fetcher{
+		log:    log,
+		k8sCli: k8sCli,
+	}
+}
+
+// AttachToEvent fetches the most recent logs of the failing container and sets them on a given Pod error event.
+// For restarted containers, the logs of the previous container instance are fetched.
+func (f *Fetcher) AttachToEvent(ctx context.Context, event *events.Event, cfg config.PodLogs) error {
+	if !cfg.Enabled || event.Kind != podKind || event.Type != config.ErrorEvent {
+		return nil
+	}
+	if _, ok := logsReasons[event.Reason]; !ok {
+		return nil
+	}
+
+	pod, err := f.k8sCli.CoreV1().Pods(event.Namespace).Get(ctx, event.Name, metaV1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("while getting Pod %q: %w", fmt.Sprintf("%s/%s", event.Namespace, event.Name), err)
+	}
+
+	status, found := failingContainer(pod, containerFromEvent(event))
+	if !found {
+		f.log.Debugf("No failing container found for Pod %s/%s", event.Namespace, event.Name)
+		return nil
+	}
+
+	opts := &coreV1.PodLogOptions{
+		Container:  status.Name,
+		Previous:   status.RestartCount > 0,
+		TailLines:  valueOrDefault(cfg.TailLines, DefaultTailLines),
+		LimitBytes: valueOrDefault(cfg.MaxBytes, DefaultMaxBytes),
+	}
+
+	logs, err := f.getLogs(ctx, pod, opts)
+	if err != nil {
+		return err
+	}
+
+	event.Logs = strings.TrimSpace(logs)
+	return nil
+}
+
+func (f *Fetcher) getLogs(ctx context.Context, pod *coreV1.Pod, opts *coreV1.PodLogOptions) (string, error) {
+	stream, err := f.k8sCli.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+	if err != nil {
+		return "", fmt.Errorf("while streaming logs of container %q: %w", opts.Container, err)
+	}
+	defer stream.Close()
+
+	out, err := io.ReadAll(io.LimitReader(stream, *opts.LimitBytes))
+	if err != nil {
+		return "", fmt.Errorf("while reading logs of container %q: %w", opts.Container, err)
+	}
+
+	return string(out), nil
+}
+
+// failingContainer returns the status of a given container, or the first container which is crash looping, terminated with an error or restarted.
+// Containers which never started are skipped, as they don't have any logs.
+func failingContainer(pod *coreV1.Pod, name string) (coreV1.ContainerStatus, bool) {
+	var statuses []coreV1.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+
+	for _, status := range statuses {
+		if name != "" && status.Name != name {
+			continue
+		}
+
+		if isFailing(status) {
+			return status, true
+		}
+	}
+
+	return coreV1.ContainerStatus{}, false
+}
+
+func isFailing(status coreV1.ContainerStatus) bool {
+	switch {
+	case status.RestartCount > 0:
+		return true
+	case status.State.Waiting != nil && status.State.Waiting.Reason == crashLoopBackOffReason:
+		return true
+	case status.State.Terminated != nil && status.State.Terminated.ExitCode != 0:
+		// e.g. ContainerCannotRun terminates the container which never started
+		return !status.State.Terminated.StartedAt.IsZero()
+	}
+	return false
+}
+
+// containerFromEvent returns the container name referenced by the Kubernetes Event.
+// If the event was not created from the Kubernetes Event, or it doesn't reference a container, it returns empty string.
+func containerFromEvent(event *events.Event) string {
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok || unstrObj.GetKind() != "Event" {
+		return ""
+	}
+
+	k8sEvent, err := utils.TransformIntoCoreEvent(unstrObj)
+	if err != nil {
+		return ""
+	}
+
+	matches := containerFieldPathRegex.FindStringSubmatch(k8sEvent.InvolvedObject.FieldPath)
+	if len(matches) != 2 {
+		return ""
+	}
+	return matches[1]
+}
+
+func valueOrDefault(in, def int64) *int64 {
+	if in > 0 {
+		return &in
+	}
+	return &def
+}
@@ -0,0 +1,253 @@
+package podlogs
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestFetcher_AttachToEvent(t *testing.T) {
+	// given
+	tests := []struct {
+		name    string
+		event   events.Event
+		cfg     config.PodLogs
+		expLogs string
+	}{
+		{
+			name:    "Pod error event",
+			event:   fixPodErrorEvent("Pod"),
+			cfg:     config.PodLogs{Enabled: true},
+			expLogs: "fake logs", // returned by the fake client
+		},
+		{
+			name:  "Disabled",
+			event: fixPodErrorEvent("Pod"),
+			cfg:   config.PodLogs{Enabled: false},
+		},
+		{
+			name:  "Not a Pod",
+			event: fixPodErrorEvent("Deployment"),
+			cfg:   config.PodLogs{Enabled: true},
+		},
+		{
+			name: "Reason without container logs",
+			event: func() events.Event {
+				event := fixPodErrorEvent("Pod")
+				event.Reason = "FailedMount"
+				return event
+			}(),
+			cfg: config.PodLogs{Enabled: true},
+		},
+		{
+			name: "Not an error event",
+			event: func() events.Event {
+				event := fixPodErrorEvent("Pod")
+				event.Type = config.CreateEvent
+				return event
+			}(),
+			cfg: config.PodLogs{Enabled: true},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logger, _ := logtest.NewNullLogger()
+			fetcher := NewFetcher(logger, fake.NewSimpleClientset(fixCrashingPod()))
+			event := tc.event
+
+			// when
+			err := fetcher.AttachToEvent(context.Background(), &event, tc.cfg)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expLogs, event.Logs)
+		})
+	}
+}
+
+func TestFetcher_AttachToEventPodNotFound(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	fetcher := NewFetcher(logger, fake.NewSimpleClientset())
+	event := fixPodErrorEvent("Pod")
+
+	// when
+	err := fetcher.AttachToEvent(context.Background(), &event, config.PodLogs{Enabled: true})
+
+	// then
+	assert.EqualError(t, err, `while getting Pod "team-a/api": pods "api" not found`)
+	assert.Empty(t, event.Logs)
+}
+
+func TestFailingContainer(t *testing.T) {
+	// given
+	pod := fixCrashingPod()
+
+	tests := []struct {
+		name         string
+		container    string
+		expFound     bool
+		expContainer string
+	}{
+		{
+			name:         "Container referenced by the event",
+			container:    "sidecar",
+			expFound:     true,
+			expContainer: "sidecar",
+		},
+		{
+			name:         "First failing container",
+			expFound:     true,
+			expContainer: "app",
+		},
+		{
+			name:      "Unknown container",
+			container: "other",
+			expFound:  false,
+		},
+		{
+			name:      "Referenced container is not failing",
+			container: "healthy",
+			expFound:  false,
+		},
+		{
+			name:      "Referenced container never started",
+			container: "pending",
+			expFound:  false,
+		},
+		{
+			name:      "Referenced container terminated before start",
+			container: "cannot-run",
+			expFound:  false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			status, found := failingContainer(pod, tc.container)
+
+			// then
+			assert.Equal(t, tc.expFound, found)
+			assert.Equal(t, tc.expContainer, status.Name)
+		})
+	}
+}
+
+func TestContainerFromEvent(t *testing.T) {
+	tests := []struct {
+		name      string
+		fieldPath string
+		expected  string
+	}{
+		{
+			name:      "Container",
+			fieldPath: "spec.containers{app}",
+			expected:  "app",
+		},
+		{
+			name:      "Init container",
+			fieldPath: "spec.initContainers{migrate}",
+			expected:  "migrate",
+		},
+		{
+			name:      "No container reference",
+			fieldPath: "",
+			expected:  "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			event := fixPodErrorEvent("Pod")
+			event.Object = fixK8sEvent(t, tc.fieldPath)
+
+			// when
+			actual := containerFromEvent(&event)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func fixPodErrorEvent(kind string) events.Event {
+	return events.Event{
+		TypeMeta:  metaV1.TypeMeta{Kind: kind, APIVersion: "v1"},
+		Name:      "api",
+		Namespace: "team-a",
+		Type:      config.ErrorEvent,
+		Reason:    "BackOff",
+	}
+}
+
+func fixCrashingPod() *coreV1.Pod {
+	return &coreV1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: "api", Namespace: "team-a"},
+		Status: coreV1.PodStatus{
+			ContainerStatuses: []coreV1.ContainerStatus{
+				{
+					Name: "healthy",
+					State: coreV1.ContainerState{
+						Running: &coreV1.ContainerStateRunning{},
+					},
+				},
+				{
+					Name: "pending",
+					State: coreV1.ContainerState{
+						Waiting: &coreV1.ContainerStateWaiting{Reason: "ErrImagePull"},
+					},
+				},
+				{
+					Name: "cannot-run",
+					State: coreV1.ContainerState{
+						Terminated: &coreV1.ContainerStateTerminated{Reason: "ContainerCannotRun", ExitCode: 128},
+					},
+				},
+				{
+					Name:         "app",
+					RestartCount: 3,
+					State: coreV1.ContainerState{
+						Waiting: &coreV1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+				},
+				{
+					Name:         "sidecar",
+					RestartCount: 1,
+					State: coreV1.ContainerState{
+						Running: &coreV1.ContainerStateRunning{},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fixK8sEvent(t *testing.T, fieldPath string) *unstructured.Unstructured {
+	t.Helper()
+
+	k8sEvent := &coreV1.Event{
+		TypeMeta:   metaV1.TypeMeta{Kind: "Event", APIVersion: "v1"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "api.1", Namespace: "team-a"},
+		InvolvedObject: coreV1.ObjectReference{
+			Kind:      "Pod",
+			Name:      "api",
+			Namespace: "team-a",
+			FieldPath: fieldPath,
+		},
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(k8sEvent)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: obj}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/events"
 	"github.com/kubeshop/botkube/pkg/multierror"
 )
@@ -40,9 +41,62 @@ func (s AggregatedRunner) Do(ctx context.Context, event *events.Event) error {
 			errs = multierror.Append(errs, fmt.Errorf("while running recommendation %q: %w", r.Name(), err))
 		}
 
+		result, err = s.newFindings(ctx, r, *event, result)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("while running recommendation %q for previous object state: %w", r.Name(), err))
+		}
+
 		event.Recommendations = append(event.Recommendations, result.Info...)
 		event.Warnings = append(event.Warnings, result.Warnings...)
 	}
 
 	return errs.ErrorOrNil()
 }
+
+// newFindings returns only the findings which weren't reported for the previous state of the updated object.
+// For other events, the result is returned as it is.
+func (s AggregatedRunner) newFindings(ctx context.Context, r Recommendation, event events.Event, result Result) (Result, error) {
+	if event.Type != config.UpdateEvent || event.OldObject == nil {
+		return result, nil
+	}
+	if len(result.Info) == 0 && len(result.Warnings) == 0 {
+		return result, nil
+	}
+
+	oldEvent := event
+	oldEvent.Object = event.OldObject
+	oldEvent.OldObject = nil
+	oldEvent.Recommendations = nil
+	oldEvent.Warnings = nil
+
+	oldResult, err := r.Do(ctx, oldEvent)
+	if err != nil {
+		// report all findings, as they cannot be compared
+		return result, err
+	}
+
+	return Result{
+		Info:     withoutMessages(result.Info, oldResult.Info),
+		Warnings: withoutMessages(result.Warnings, oldResult.Warnings),
+	}, nil
+}
+
+func withoutMessages(msgs, toRemove []string) []string {
+	if len(msgs) == 0 || len(toRemove) == 0 {
+		return msgs
+	}
+
+	removed := make(map[string]struct{}, len(toRemove))
+	for _, msg := range toRemove {
+		removed[msg] = struct{}{}
+	}
+
+	var out []string
+	for _, msg := range msgs {
+		if _, found := removed[msg]; found {
+			continue
+		}
+		out = append(out, msg)
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestAggregatedRunner_DoReportsOnlyNewFindingsOnUpdate(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	runner := recommendation.NewAggregatedRunner(logger, []recommendation.Recommendation{
+		recommendation.NewPodResourcesSet(),
+	})
+
+	oldPod := fixPod()
+	oldPod.Spec.InitContainers = nil
+	oldPod.Spec.Containers = []v1.Container{{Name: "first"}}
+
+	pod := oldPod.DeepCopy()
+	pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Name: "second"})
+
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	oldUnstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(oldPod)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		Name      string
+		OldObject interface{}
+		Expected  []string
+	}{
+		{
+			Name:      "Unchanged object",
+			OldObject: &unstructured.Unstructured{Object: unstrObj},
+		},
+		{
+			Name:      "Added container",
+			OldObject: &unstructured.Unstructured{Object: oldUnstrObj},
+			Expected: []string{
+				"Pod 'foo/pod-name' container 'second' doesn't define CPU request, memory request, CPU limit, memory limit. Consider setting them, to make scheduling and resource usage predictable.",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			event, err := events.New(pod.ObjectMeta, &unstructured.Unstructured{Object: unstrObj}, config.UpdateEvent, "v1/pods", "sample")
+			require.NoError(t, err)
+			event.OldObject = testCase.OldObject
+
+			// when
+			err = runner.Do(context.Background(), &event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, event.Recommendations)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package recommendation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+// Custom adds a user-defined recommendation when a newly created resource matches a JSONPath condition.
+type Custom struct {
+	cfg       config.CustomRecommendation
+	condition *jsonpath.JSONPath
+	message   *template.Template
+}
+
+// CustomMessageData holds data available in the custom recommendation message template.
+type CustomMessageData struct {
+	events.Event
+
+	// Matches contains values returned by the condition.
+	Matches []string
+}
+
+// NewCustom creates a new Custom instance.
+func NewCustom(cfg config.CustomRecommendation) (*Custom, error) {
+	condition, err := cfg.ParseCondition()
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := cfg.ParseMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Custom{
+		cfg:       cfg,
+		condition: condition,
+		message:   message,
+	}, nil
+}
+
+// Do executes the recommendation checks.
+func (c *Custom) Do(_ context.Context, event events.Event) (Result, error) {
+	if event.Resource != c.cfg.Resource || event.Type != config.CreateEvent {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	matches, err := c.evaluateCondition(unstrObj.Object)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(matches) == 0 {
+		return Result{}, nil
+	}
+
+	buf := new(bytes.Buffer)
+	err = c.message.Execute(buf, CustomMessageData{Event: event, Matches: matches})
+	if err != nil {
+		return Result{}, fmt.Errorf("while rendering message: %w", err)
+	}
+
+	if c.cfg.Severity == config.WarningRecommendationSeverity {
+		return Result{Warnings: []string{buf.String()}}, nil
+	}
+
+	return Result{Info: []string{buf.String()}}, nil
+}
+
+// Name returns the recommendation name.
+func (c *Custom) Name() string {
+	return c.cfg.Name
+}
+
+func (c *Custom) evaluateCondition(obj map[string]interface{}) ([]string, error) {
+	results, err := c.condition.FindResults(obj)
+	if err != nil {
+		return nil, fmt.Errorf("while evaluating condition %q: %w", c.cfg.Condition, err)
+	}
+
+	var matches []string
+	for _, values := range results {
+		for _, value := range values {
+			if !value.IsValid() || !value.CanInterface() || value.Interface() == nil {
+				continue
+			}
+
+			str := fmt.Sprintf("%v", value.Interface())
+			if str == "" || str == "false" {
+				continue
+			}
+			matches = append(matches, str)
+		}
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,112 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/ptr"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestCustom_Do(t *testing.T) {
+	// given
+	pod := fixPod()
+	pod.Spec.Containers[0].SecurityContext = &v1.SecurityContext{Privileged: ptr.Bool(true)}
+	pod.Spec.Containers[1].SecurityContext = &v1.SecurityContext{Privileged: ptr.Bool(false)}
+
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	createEvent, err := events.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods", "sample")
+	require.NoError(t, err)
+	updateEvent, err := events.New(pod.ObjectMeta, unstr, config.UpdateEvent, "v1/pods", "sample")
+	require.NoError(t, err)
+
+	privilegedCfg := config.CustomRecommendation{
+		Name:      "PodNotPrivileged",
+		Resource:  "v1/pods",
+		Condition: "{.spec.containers[?(@.securityContext.privileged==true)].name}",
+		Message:   `Pod '{{ .Namespace }}/{{ .Name }}' runs privileged containers: {{ join ", " .Matches }}.`,
+		Severity:  config.WarningRecommendationSeverity,
+	}
+
+	testCases := []struct {
+		Name     string
+		Cfg      config.CustomRecommendation
+		Event    events.Event
+		Expected recommendation.Result
+	}{
+		{
+			Name:  "Condition matched",
+			Cfg:   privilegedCfg,
+			Event: createEvent,
+			Expected: recommendation.Result{
+				Warnings: []string{"Pod 'foo/pod-name' runs privileged containers: first."},
+			},
+		},
+		{
+			Name:  "Condition not matched",
+			Cfg:   config.CustomRecommendation{Name: "HostNetwork", Resource: "v1/pods", Condition: "{.spec.hostNetwork}", Message: "Pod uses host network."},
+			Event: createEvent,
+		},
+		{
+			Name: "Info severity by default",
+			Cfg: config.CustomRecommendation{
+				Name:      "InitContainers",
+				Resource:  "v1/pods",
+				Condition: "{.spec.initContainers[*].name}",
+				Message:   "Pod '{{ .Name }}' has {{ len .Matches }} init containers.",
+			},
+			Event: createEvent,
+			Expected: recommendation.Result{
+				Info: []string{"Pod 'pod-name' has 4 init containers."},
+			},
+		},
+		{
+			Name:  "Different event type",
+			Cfg:   privilegedCfg,
+			Event: updateEvent,
+		},
+		{
+			Name:  "Different resource",
+			Cfg:   config.CustomRecommendation{Name: "Deployment", Resource: "apps/v1/deployments", Condition: "{.metadata.name}", Message: "Deployment."},
+			Event: createEvent,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			recomm, err := recommendation.NewCustom(testCase.Cfg)
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), testCase.Event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
+
+func TestNewCustom_InvalidCondition(t *testing.T) {
+	// when
+	_, err := recommendation.NewCustom(config.CustomRecommendation{
+		Name:      "Invalid",
+		Resource:  "v1/pods",
+		Condition: "{.spec.containers[",
+		Message:   "Invalid.",
+	})
+
+	// then
+	assert.ErrorContains(t, err, `while parsing condition "{.spec.containers["`)
+}
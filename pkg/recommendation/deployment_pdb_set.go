@@ -0,0 +1,132 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	appsV1 "k8s.io/api/apps/v1"
+	policyV1 "k8s.io/api/policy/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const deploymentPodDisruptionBudgetSetName = "DeploymentPodDisruptionBudgetSet"
+
+// DeploymentPodDisruptionBudgetSet adds recommendations if Deployments with multiple replicas aren't covered by any PodDisruptionBudget.
+type DeploymentPodDisruptionBudgetSet struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewDeploymentPodDisruptionBudgetSet creates a new DeploymentPodDisruptionBudgetSet instance.
+func NewDeploymentPodDisruptionBudgetSet(dynamicCli dynamic.Interface) *DeploymentPodDisruptionBudgetSet {
+	return &DeploymentPodDisruptionBudgetSet{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *DeploymentPodDisruptionBudgetSet) Do(ctx context.Context, event events.Event) (Result, error) {
+	if !isCreatedOrUpdatedKind(event, "Deployment") {
+		return Result{}, nil
+	}
+
+	deploy, err := deploymentFromObject(event.Object)
+	if err != nil {
+		return Result{}, err
+	}
+
+	replicas := deploymentReplicas(deploy)
+	if replicas <= 1 {
+		return Result{}, nil
+	}
+
+	if event.Type == config.UpdateEvent && event.OldObject != nil {
+		oldDeploy, err := deploymentFromObject(event.OldObject)
+		if err != nil {
+			return Result{}, err
+		}
+
+		// avoid listing PodDisruptionBudgets when fields relevant for the check didn't change
+		if deploymentReplicas(oldDeploy) == replicas && labels.Equals(oldDeploy.Spec.Template.Labels, deploy.Spec.Template.Labels) {
+			return Result{}, nil
+		}
+	}
+
+	covered, err := f.isCoveredByPodDisruptionBudget(ctx, deploy)
+	if err != nil {
+		return Result{}, fmt.Errorf("while checking PodDisruptionBudgets: %w", err)
+	}
+	if covered {
+		return Result{}, nil
+	}
+
+	recommendationMsg := fmt.Sprintf("Deployment '%s/%s' with %d replicas is not covered by any PodDisruptionBudget. Consider defining one, to keep the application available during voluntary disruptions.", deploy.Namespace, deploy.Name, replicas)
+	return Result{
+		Info: []string{recommendationMsg},
+	}, nil
+}
+
+func deploymentFromObject(obj interface{}) (appsV1.Deployment, error) {
+	var deploy appsV1.Deployment
+	unstrObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return deploy, fmt.Errorf("cannot convert %T into type %T", obj, unstrObj)
+	}
+
+	err := utils.TransformIntoTypedObject(unstrObj, &deploy)
+	if err != nil {
+		return deploy, fmt.Errorf("while transforming object type %T into type: %T: %w", obj, deploy, err)
+	}
+
+	return deploy, nil
+}
+
+func deploymentReplicas(deploy appsV1.Deployment) int32 {
+	if deploy.Spec.Replicas == nil {
+		return 1
+	}
+	return *deploy.Spec.Replicas
+}
+
+func (f *DeploymentPodDisruptionBudgetSet) isCoveredByPodDisruptionBudget(ctx context.Context, deploy appsV1.Deployment) (bool, error) {
+	pdbGVR := schema.GroupVersionResource{
+		Group:    "policy",
+		Version:  "v1",
+		Resource: "poddisruptionbudgets",
+	}
+	list, err := f.dynamicCli.Resource(pdbGVR).Namespace(deploy.Namespace).List(ctx, metaV1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	podLabels := labels.Set(deploy.Spec.Template.Labels)
+	for _, item := range list.Items {
+		item := item
+		var pdb policyV1.PodDisruptionBudget
+		err := utils.TransformIntoTypedObject(&item, &pdb)
+		if err != nil {
+			return false, fmt.Errorf("while transforming object type %T into type: %T: %w", item, pdb, err)
+		}
+
+		selector, err := metaV1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			return false, fmt.Errorf("while parsing selector of PodDisruptionBudget %q: %w", pdb.Name, err)
+		}
+
+		if selector.Matches(podLabels) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Name returns the recommendation name.
+func (f *DeploymentPodDisruptionBudgetSet) Name() string {
+	return deploymentPodDisruptionBudgetSetName
+}
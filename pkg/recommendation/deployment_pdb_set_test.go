@@ -0,0 +1,173 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestDeploymentPodDisruptionBudgetSet_Do(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Replicas int32
+		Labels   map[string]string
+		Expected recommendation.Result
+	}{
+		{
+			Name:     "Not covered",
+			Replicas: 3,
+			Labels:   map[string]string{"app": "api"},
+			Expected: recommendation.Result{
+				Info: []string{
+					"Deployment 'foo/deploy-name' with 3 replicas is not covered by any PodDisruptionBudget. Consider defining one, to keep the application available during voluntary disruptions.",
+				},
+			},
+		},
+		{
+			Name:     "Covered",
+			Replicas: 3,
+			Labels:   map[string]string{"app": "web", "tier": "frontend"},
+		},
+		{
+			Name:     "Single replica",
+			Replicas: 1,
+			Labels:   map[string]string{"app": "api"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixPodDisruptionBudget())
+			recomm := recommendation.NewDeploymentPodDisruptionBudgetSet(dynamicCli)
+
+			deploy := fixDeployment(testCase.Replicas, testCase.Labels)
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deploy)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := events.New(deploy.ObjectMeta, unstr, config.CreateEvent, "apps/v1/deployments", "sample")
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
+
+func TestDeploymentPodDisruptionBudgetSet_DoOnUpdate(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name             string
+		OldReplicas      int32
+		OldLabels        map[string]string
+		ExpectedListCall bool
+		Expected         recommendation.Result
+	}{
+		{
+			Name:        "Unchanged replicas and labels",
+			OldReplicas: 3,
+			OldLabels:   map[string]string{"app": "api"},
+		},
+		{
+			Name:             "Scaled up",
+			OldReplicas:      1,
+			OldLabels:        map[string]string{"app": "api"},
+			ExpectedListCall: true,
+			Expected: recommendation.Result{
+				Info: []string{
+					"Deployment 'foo/deploy-name' with 3 replicas is not covered by any PodDisruptionBudget. Consider defining one, to keep the application available during voluntary disruptions.",
+				},
+			},
+		},
+		{
+			Name:             "Changed labels",
+			OldReplicas:      3,
+			OldLabels:        map[string]string{"app": "web"},
+			ExpectedListCall: true,
+			Expected: recommendation.Result{
+				Info: []string{
+					"Deployment 'foo/deploy-name' with 3 replicas is not covered by any PodDisruptionBudget. Consider defining one, to keep the application available during voluntary disruptions.",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixPodDisruptionBudget())
+			recomm := recommendation.NewDeploymentPodDisruptionBudgetSet(dynamicCli)
+
+			deploy := fixDeployment(3, map[string]string{"app": "api"})
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(deploy)
+			require.NoError(t, err)
+			oldUnstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(fixDeployment(testCase.OldReplicas, testCase.OldLabels))
+			require.NoError(t, err)
+
+			event, err := events.New(deploy.ObjectMeta, &unstructured.Unstructured{Object: unstrObj}, config.UpdateEvent, "apps/v1/deployments", "sample")
+			require.NoError(t, err)
+			event.OldObject = &unstructured.Unstructured{Object: oldUnstrObj}
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+			assert.Equal(t, testCase.ExpectedListCall, len(dynamicCli.Actions()) > 0)
+		})
+	}
+}
+
+func fixDeployment(replicas int32, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "deploy-name",
+			Namespace: "foo",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			},
+		},
+	}
+}
+
+func fixPodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: "policy/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web",
+			Namespace: "foo",
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+		},
+	}
+}
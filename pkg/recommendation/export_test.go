@@ -1,5 +1,11 @@
 package recommendation
 
+import "github.com/sirupsen/logrus"
+
+func NewAggregatedRunner(log logrus.FieldLogger, recommendations []Recommendation) AggregatedRunner {
+	return newAggregatedRunner(log, recommendations)
+}
+
 func (s *AggregatedRunner) Recommendations() []Recommendation {
 	return s.recommendations
 }
@@ -11,3 +17,7 @@ func PodResourceName() string {
 func IngressResourceName() string {
 	return ingressResourceName
 }
+
+func DeploymentResourceName() string {
+	return deploymentsResourceName
+}
@@ -2,6 +2,7 @@ package recommendation
 
 import (
 	"context"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/client-go/dynamic"
@@ -27,11 +28,20 @@ type Result struct {
 type Factory struct {
 	logger     logrus.FieldLogger
 	dynamicCli dynamic.Interface
+
+	// customs holds compiled custom recommendations, so their conditions and messages are parsed only once.
+	// Invalid recommendations are stored as nil values.
+	customsMu sync.Mutex
+	customs   map[config.CustomRecommendation]*Custom
 }
 
 // NewFactory creates a new Factory instance.
 func NewFactory(logger logrus.FieldLogger, dynamicCli dynamic.Interface) *Factory {
-	return &Factory{logger: logger, dynamicCli: dynamicCli}
+	return &Factory{
+		logger:     logger,
+		dynamicCli: dynamicCli,
+		customs:    map[config.CustomRecommendation]*Custom{},
+	}
 }
 
 // NewForSources merges recommendation options from multiple sources, and creates a new AggregatedRunner.
@@ -56,12 +66,40 @@ func (f *Factory) mergeConfig(sources map[string]config.Sources, mapKeyOrder []s
 		if sourceCfg.Pod.NoLatestImageTag != nil {
 			mergedCfg.Pod.NoLatestImageTag = sourceCfg.Pod.NoLatestImageTag
 		}
+		if sourceCfg.Pod.ResourcesSet != nil {
+			mergedCfg.Pod.ResourcesSet = sourceCfg.Pod.ResourcesSet
+		}
+		if sourceCfg.Pod.ProbesSet != nil {
+			mergedCfg.Pod.ProbesSet = sourceCfg.Pod.ProbesSet
+		}
+		if sourceCfg.Pod.NoRootOrPrivileged != nil {
+			mergedCfg.Pod.NoRootOrPrivileged = sourceCfg.Pod.NoRootOrPrivileged
+		}
+		if sourceCfg.Pod.NoHostPathVolumes != nil {
+			mergedCfg.Pod.NoHostPathVolumes = sourceCfg.Pod.NoHostPathVolumes
+		}
+		if sourceCfg.Deployment.PodDisruptionBudgetSet != nil {
+			mergedCfg.Deployment.PodDisruptionBudgetSet = sourceCfg.Deployment.PodDisruptionBudgetSet
+		}
+		if sourceCfg.Service.SelectorValid != nil {
+			mergedCfg.Service.SelectorValid = sourceCfg.Service.SelectorValid
+		}
+		if sourceCfg.Service.TargetPortValid != nil {
+			mergedCfg.Service.TargetPortValid = sourceCfg.Service.TargetPortValid
+		}
+		if sourceCfg.NetworkPolicy.PodSelectorValid != nil {
+			mergedCfg.NetworkPolicy.PodSelectorValid = sourceCfg.NetworkPolicy.PodSelectorValid
+		}
+		if sourceCfg.HorizontalPodAutoscaler.ScaleTargetValid != nil {
+			mergedCfg.HorizontalPodAutoscaler.ScaleTargetValid = sourceCfg.HorizontalPodAutoscaler.ScaleTargetValid
+		}
 		if sourceCfg.Ingress.BackendServiceValid != nil {
 			mergedCfg.Ingress.BackendServiceValid = sourceCfg.Ingress.BackendServiceValid
 		}
 		if sourceCfg.Ingress.TLSSecretValid != nil {
 			mergedCfg.Ingress.TLSSecretValid = sourceCfg.Ingress.TLSSecretValid
 		}
+		mergedCfg.Custom = mergeCustomRecommendations(mergedCfg.Custom, sourceCfg.Custom)
 	}
 
 	return mergedCfg
@@ -77,6 +115,26 @@ func (f *Factory) recommendationsForConfig(cfg config.Recommendations) []Recomme
 		recommendations = append(recommendations, NewPodNoLatestImageTag())
 	}
 
+	if ptr.IsTrue(cfg.Pod.ResourcesSet) {
+		recommendations = append(recommendations, NewPodResourcesSet())
+	}
+
+	if ptr.IsTrue(cfg.Pod.ProbesSet) {
+		recommendations = append(recommendations, NewPodProbesSet())
+	}
+
+	if ptr.IsTrue(cfg.Pod.NoRootOrPrivileged) {
+		recommendations = append(recommendations, NewPodNoRootOrPrivileged())
+	}
+
+	if ptr.IsTrue(cfg.Pod.NoHostPathVolumes) {
+		recommendations = append(recommendations, NewPodNoHostPathVolumes())
+	}
+
+	if ptr.IsTrue(cfg.Deployment.PodDisruptionBudgetSet) {
+		recommendations = append(recommendations, NewDeploymentPodDisruptionBudgetSet(f.dynamicCli))
+	}
+
 	if ptr.IsTrue(cfg.Ingress.BackendServiceValid) {
 		recommendations = append(recommendations, NewIngressBackendServiceValid(f.dynamicCli))
 	}
@@ -85,5 +143,69 @@ func (f *Factory) recommendationsForConfig(cfg config.Recommendations) []Recomme
 		recommendations = append(recommendations, NewIngressTLSSecretValid(f.dynamicCli))
 	}
 
+	if ptr.IsTrue(cfg.Service.SelectorValid) {
+		recommendations = append(recommendations, NewServiceSelectorValid(f.dynamicCli))
+	}
+
+	if ptr.IsTrue(cfg.Service.TargetPortValid) {
+		recommendations = append(recommendations, NewServiceTargetPortValid(f.dynamicCli))
+	}
+
+	if ptr.IsTrue(cfg.NetworkPolicy.PodSelectorValid) {
+		recommendations = append(recommendations, NewNetworkPolicyPodSelectorValid(f.dynamicCli))
+	}
+
+	if ptr.IsTrue(cfg.HorizontalPodAutoscaler.ScaleTargetValid) {
+		recommendations = append(recommendations, NewHPAScaleTargetValid(f.dynamicCli))
+	}
+
+	for _, customCfg := range cfg.Custom {
+		custom := f.compiledCustom(customCfg)
+		if custom == nil {
+			continue
+		}
+		recommendations = append(recommendations, custom)
+	}
+
 	return recommendations
 }
+
+// compiledCustom returns a compiled custom recommendation for a given configuration, or nil if it is invalid.
+func (f *Factory) compiledCustom(cfg config.CustomRecommendation) *Custom {
+	f.customsMu.Lock()
+	defer f.customsMu.Unlock()
+
+	if custom, found := f.customs[cfg]; found {
+		return custom
+	}
+
+	custom, err := NewCustom(cfg)
+	if err != nil {
+		// the configuration is validated when loaded, so it should happen only if the validation is bypassed
+		f.logger.Errorf("while creating custom recommendation %q: %s. Skipping...", cfg.Name, err.Error())
+		custom = nil
+	}
+	f.customs[cfg] = custom
+	return custom
+}
+
+// mergeCustomRecommendations appends custom recommendations, overriding the ones with the same name.
+func mergeCustomRecommendations(current, overrides []config.CustomRecommendation) []config.CustomRecommendation {
+	for _, override := range overrides {
+		overridden := false
+		for i := range current {
+			if current[i].Name != override.Name {
+				continue
+			}
+			current[i] = override
+			overridden = true
+			break
+		}
+
+		if !overridden {
+			current = append(current, override)
+		}
+	}
+
+	return current
+}
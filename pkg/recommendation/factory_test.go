@@ -93,3 +93,77 @@ func TestFactory_NewForSources(t *testing.T) {
 
 	assert.Equal(t, expectedNames, actualNames)
 }
+
+func TestFactory_NewForSourcesMergesCustomRecommendations(t *testing.T) {
+	// given
+	sources := map[string]config.Sources{
+		"first": {
+			Kubernetes: config.KubernetesSource{
+				Recommendations: config.Recommendations{
+					Custom: []config.CustomRecommendation{
+						{Name: "NoReplicas", Resource: "apps/v1/deployments", Condition: "{.spec.replicas}", Message: "first"},
+						{Name: "NoLimits", Resource: "v1/pods", Condition: "{.spec.containers[*].name}", Message: "limits"},
+					},
+				},
+			},
+		},
+		"second": {
+			Kubernetes: config.KubernetesSource{
+				Recommendations: config.Recommendations{
+					Custom: []config.CustomRecommendation{
+						{Name: "NoReplicas", Resource: "apps/v1/deployments", Condition: "{.spec.replicas}", Message: "second"},
+						{Name: "Invalid", Resource: "v1/pods", Condition: "{.spec.containers[", Message: "invalid"},
+					},
+				},
+			},
+		},
+	}
+	expectedCustomCfg := []config.CustomRecommendation{
+		{Name: "NoReplicas", Resource: "apps/v1/deployments", Condition: "{.spec.replicas}", Message: "second"},
+		{Name: "NoLimits", Resource: "v1/pods", Condition: "{.spec.containers[*].name}", Message: "limits"},
+		{Name: "Invalid", Resource: "v1/pods", Condition: "{.spec.containers[", Message: "invalid"},
+	}
+
+	logger, _ := logtest.NewNullLogger()
+	factory := recommendation.NewFactory(logger, nil)
+
+	// when
+	recRunner, recCfg := factory.NewForSources(sources, []string{"first", "second"})
+	actualRecomms := recRunner.Recommendations()
+
+	// then
+	assert.Equal(t, expectedCustomCfg, recCfg.Custom)
+
+	var actualNames []string
+	for _, r := range actualRecomms {
+		actualNames = append(actualNames, r.Name())
+	}
+	assert.Equal(t, []string{"NoReplicas", "NoLimits"}, actualNames)
+}
+
+func TestFactory_NewForSourcesCompilesCustomRecommendationsOnce(t *testing.T) {
+	// given
+	sources := map[string]config.Sources{
+		"first": {
+			Kubernetes: config.KubernetesSource{
+				Recommendations: config.Recommendations{
+					Custom: []config.CustomRecommendation{
+						{Name: "NoReplicas", Resource: "apps/v1/deployments", Condition: "{.spec.replicas}", Message: "first"},
+					},
+				},
+			},
+		},
+	}
+
+	logger, _ := logtest.NewNullLogger()
+	factory := recommendation.NewFactory(logger, nil)
+
+	// when
+	firstRunner, _ := factory.NewForSources(sources, []string{"first"})
+	secondRunner, _ := factory.NewForSources(sources, []string{"first"})
+
+	// then
+	require.Len(t, firstRunner.Recommendations(), 1)
+	require.Len(t, secondRunner.Recommendations(), 1)
+	assert.Same(t, firstRunner.Recommendations()[0], secondRunner.Recommendations()[0])
+}
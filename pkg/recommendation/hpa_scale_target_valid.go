@@ -0,0 +1,87 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const hpaScaleTargetValidName = "HPAScaleTargetValid"
+
+// HPAScaleTargetValid adds recommendations if the scale target referred in HorizontalPodAutoscaler spec doesn't exist.
+type HPAScaleTargetValid struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewHPAScaleTargetValid creates a new HPAScaleTargetValid instance.
+func NewHPAScaleTargetValid(dynamicCli dynamic.Interface) *HPAScaleTargetValid {
+	return &HPAScaleTargetValid{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *HPAScaleTargetValid) Do(ctx context.Context, event events.Event) (Result, error) {
+	if event.Kind != "HorizontalPodAutoscaler" || event.Type != config.CreateEvent || utils.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var hpa autoscalingv2.HorizontalPodAutoscaler
+	err := utils.TransformIntoTypedObject(unstrObj, &hpa)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, hpa, err)
+	}
+
+	ref := hpa.Spec.ScaleTargetRef
+	exists, err := f.validateScaleTargetExists(ctx, ref, hpa.Namespace)
+	if err != nil {
+		return Result{}, fmt.Errorf("while validating scale target existence: %w", err)
+	}
+
+	if exists {
+		return Result{}, nil
+	}
+
+	warningMsg := fmt.Sprintf("%s '%s' referred in HorizontalPodAutoscaler '%s/%s' does not exist.", ref.Kind, ref.Name, hpa.Namespace, hpa.Name)
+	return Result{
+		Warnings: []string{warningMsg},
+	}, nil
+}
+
+func (f *HPAScaleTargetValid) validateScaleTargetExists(ctx context.Context, ref autoscalingv2.CrossVersionObjectReference, namespace string) (bool, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return false, fmt.Errorf("while parsing API version %q: %w", ref.APIVersion, err)
+	}
+
+	// The scale target kinds, such as Deployment or StatefulSet, follow the regular pluralization rules.
+	gvr, _ := meta.UnsafeGuessKindToResource(gv.WithKind(ref.Kind))
+	_, err = f.dynamicCli.Resource(gvr).Namespace(namespace).Get(ctx, ref.Name, metaV1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Name returns the recommendation name.
+func (f *HPAScaleTargetValid) Name() string {
+	return hpaScaleTargetValidName
+}
@@ -0,0 +1,74 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestHPAScaleTargetValid_Do(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Target   autoscalingv2.CrossVersionObjectReference
+		Expected recommendation.Result
+	}{
+		{
+			Name:   "Existing target",
+			Target: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "deploy-name"},
+		},
+		{
+			Name:   "Not existing target",
+			Target: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "deploy-name"},
+			Expected: recommendation.Result{
+				Warnings: []string{"StatefulSet 'deploy-name' referred in HorizontalPodAutoscaler 'foo/hpa-name' does not exist."},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixDeployment(2, nil))
+			recomm := recommendation.NewHPAScaleTargetValid(dynamicCli)
+
+			hpa := &autoscalingv2.HorizontalPodAutoscaler{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "HorizontalPodAutoscaler",
+					APIVersion: "autoscaling/v2",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "hpa-name",
+					Namespace: "foo",
+				},
+				Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: testCase.Target,
+				},
+			}
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(hpa)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := events.New(hpa.ObjectMeta, unstr, config.CreateEvent, "autoscaling/v2/horizontalpodautoscalers", "sample")
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
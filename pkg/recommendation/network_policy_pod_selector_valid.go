@@ -0,0 +1,69 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const networkPolicyPodSelectorValidName = "NetworkPolicyPodSelectorValid"
+
+// NetworkPolicyPodSelectorValid adds recommendations if NetworkPolicy doesn't select any Pod.
+type NetworkPolicyPodSelectorValid struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewNetworkPolicyPodSelectorValid creates a new NetworkPolicyPodSelectorValid instance.
+func NewNetworkPolicyPodSelectorValid(dynamicCli dynamic.Interface) *NetworkPolicyPodSelectorValid {
+	return &NetworkPolicyPodSelectorValid{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *NetworkPolicyPodSelectorValid) Do(ctx context.Context, event events.Event) (Result, error) {
+	if event.Kind != "NetworkPolicy" || event.Type != config.CreateEvent || utils.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return Result{}, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return Result{}, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	var policy networkingv1.NetworkPolicy
+	err := utils.TransformIntoTypedObject(unstrObj, &policy)
+	if err != nil {
+		return Result{}, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, policy, err)
+	}
+
+	selector, err := metaV1.LabelSelectorAsSelector(&policy.Spec.PodSelector)
+	if err != nil {
+		return Result{}, fmt.Errorf("while parsing Pod selector: %w", err)
+	}
+
+	pods, err := listPods(ctx, f.dynamicCli, policy.Namespace, selector)
+	if err != nil {
+		return Result{}, fmt.Errorf("while listing Pods: %w", err)
+	}
+
+	if len(pods) > 0 {
+		return Result{}, nil
+	}
+
+	warningMsg := fmt.Sprintf("NetworkPolicy '%s/%s' doesn't select any Pod.", policy.Namespace, policy.Name)
+	return Result{
+		Warnings: []string{warningMsg},
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *NetworkPolicyPodSelectorValid) Name() string {
+	return networkPolicyPodSelectorValidName
+}
@@ -0,0 +1,82 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestNetworkPolicyPodSelectorValid_Do(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Selector metav1.LabelSelector
+		Expected recommendation.Result
+	}{
+		{
+			Name:     "Matching Pods",
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+		},
+		{
+			Name:     "Empty selector",
+			Selector: metav1.LabelSelector{},
+		},
+		{
+			Name: "No matching Pods",
+			Selector: metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"web", "worker"}},
+				},
+			},
+			Expected: recommendation.Result{
+				Warnings: []string{"NetworkPolicy 'foo/policy-name' doesn't select any Pod."},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixLabeledPod())
+			recomm := recommendation.NewNetworkPolicyPodSelectorValid(dynamicCli)
+
+			policy := &networkingv1.NetworkPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "NetworkPolicy",
+					APIVersion: "networking.k8s.io/v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "policy-name",
+					Namespace: "foo",
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PodSelector: testCase.Selector,
+				},
+			}
+			unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(policy)
+			require.NoError(t, err)
+			unstr := &unstructured.Unstructured{Object: unstrObj}
+
+			event, err := events.New(policy.ObjectMeta, unstr, config.CreateEvent, "networking.k8s.io/v1/networkpolicies", "sample")
+			require.NoError(t, err)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
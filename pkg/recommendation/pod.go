@@ -0,0 +1,66 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+// isCreatedOrUpdatedKind returns true if a given event is a create or update event for a given kind.
+func isCreatedOrUpdatedKind(event events.Event, kind string) bool {
+	if event.Kind != kind || utils.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return false
+	}
+
+	return event.Type == config.CreateEvent || event.Type == config.UpdateEvent
+}
+
+func podFromEvent(event events.Event) (coreV1.Pod, error) {
+	var pod coreV1.Pod
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return pod, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	err := utils.TransformIntoTypedObject(unstrObj, &pod)
+	if err != nil {
+		return pod, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, pod, err)
+	}
+
+	return pod, nil
+}
+
+// listPods returns Pods from a given namespace which match a given selector.
+func listPods(ctx context.Context, dynamicCli dynamic.Interface, namespace string, selector labels.Selector) ([]coreV1.Pod, error) {
+	podGVR := schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "pods",
+	}
+	list, err := dynamicCli.Resource(podGVR).Namespace(namespace).List(ctx, metaV1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var pods []coreV1.Pod
+	for _, item := range list.Items {
+		item := item
+		var pod coreV1.Pod
+		err := utils.TransformIntoTypedObject(&item, &pod)
+		if err != nil {
+			return nil, fmt.Errorf("while transforming object type %T into type: %T: %w", item, pod, err)
+		}
+		pods = append(pods, pod)
+	}
+
+	return pods, nil
+}
@@ -0,0 +1,49 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+const podNoHostPathVolumesName = "PodNoHostPathVolumes"
+
+// PodNoHostPathVolumes adds recommendations if Pods mount hostPath volumes.
+type PodNoHostPathVolumes struct{}
+
+// NewPodNoHostPathVolumes creates a new PodNoHostPathVolumes instance.
+func NewPodNoHostPathVolumes() *PodNoHostPathVolumes {
+	return &PodNoHostPathVolumes{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodNoHostPathVolumes) Do(_ context.Context, event events.Event) (Result, error) {
+	if !isCreatedOrUpdatedKind(event, "Pod") {
+		return Result{}, nil
+	}
+
+	pod, err := podFromEvent(event)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var warningMsgs []string
+	for _, volume := range pod.Spec.Volumes {
+		if volume.HostPath == nil {
+			continue
+		}
+
+		recommendationMsg := fmt.Sprintf("Pod '%s/%s' mounts hostPath volume '%s' with path '%s'. Avoid hostPath volumes, as they expose the node filesystem.", pod.Namespace, pod.Name, volume.Name, volume.HostPath.Path)
+		warningMsgs = append(warningMsgs, recommendationMsg)
+	}
+
+	return Result{
+		Warnings: warningMsgs,
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodNoHostPathVolumes) Name() string {
+	return podNoHostPathVolumesName
+}
@@ -0,0 +1,46 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestPodNoHostPathVolumes_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Warnings: []string{
+			"Pod 'foo/pod-name' mounts hostPath volume 'docker-sock' with path '/var/run/docker.sock'. Avoid hostPath volumes, as they expose the node filesystem.",
+		},
+	}
+
+	recomm := recommendation.NewPodNoHostPathVolumes()
+
+	pod := fixPod()
+	pod.Spec.Volumes = []v1.Volume{
+		{Name: "docker-sock", VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: "/var/run/docker.sock"}}},
+		{Name: "cache", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := events.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods", "sample")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
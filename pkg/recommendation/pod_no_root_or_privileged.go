@@ -0,0 +1,87 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/ptr"
+)
+
+const podNoRootOrPrivilegedName = "PodNoRootOrPrivileged"
+
+// PodNoRootOrPrivileged adds recommendations if Pod containers run as root or in privileged mode.
+type PodNoRootOrPrivileged struct{}
+
+// NewPodNoRootOrPrivileged creates a new PodNoRootOrPrivileged instance.
+func NewPodNoRootOrPrivileged() *PodNoRootOrPrivileged {
+	return &PodNoRootOrPrivileged{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodNoRootOrPrivileged) Do(_ context.Context, event events.Event) (Result, error) {
+	if !isCreatedOrUpdatedKind(event, "Pod") {
+		return Result{}, nil
+	}
+
+	pod, err := podFromEvent(event)
+	if err != nil {
+		return Result{}, err
+	}
+
+	podIdentifier := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+
+	warningMsgs := f.checkContainers("initContainer", pod.Spec.InitContainers, pod.Spec.SecurityContext, podIdentifier)
+	warningMsgs = append(warningMsgs, f.checkContainers("container", pod.Spec.Containers, pod.Spec.SecurityContext, podIdentifier)...)
+
+	return Result{
+		Warnings: warningMsgs,
+	}, nil
+}
+
+func (f *PodNoRootOrPrivileged) checkContainers(fieldName string, containers []coreV1.Container, podSecCtx *coreV1.PodSecurityContext, podIdentifier string) []string {
+	var recomms []string
+	for _, c := range containers {
+		if c.SecurityContext != nil && ptr.IsTrue(c.SecurityContext.Privileged) {
+			recomms = append(recomms, fmt.Sprintf("Pod '%s' %s '%s' runs in privileged mode.", podIdentifier, fieldName, c.Name))
+		}
+
+		runAsUser, runAsNonRoot := f.effectiveRunAs(c.SecurityContext, podSecCtx)
+		switch {
+		case runAsUser != nil && *runAsUser == 0:
+			recomms = append(recomms, fmt.Sprintf("Pod '%s' %s '%s' runs as root user.", podIdentifier, fieldName, c.Name))
+		case runAsUser == nil && !ptr.IsTrue(runAsNonRoot):
+			recomms = append(recomms, fmt.Sprintf("Pod '%s' %s '%s' may run as root user. Consider setting 'runAsNonRoot: true' in its security context.", podIdentifier, fieldName, c.Name))
+		}
+	}
+
+	return recomms
+}
+
+// effectiveRunAs returns the user settings of a container. Container security context takes precedence over the Pod one.
+func (f *PodNoRootOrPrivileged) effectiveRunAs(containerSecCtx *coreV1.SecurityContext, podSecCtx *coreV1.PodSecurityContext) (*int64, *bool) {
+	var (
+		runAsUser    *int64
+		runAsNonRoot *bool
+	)
+	if podSecCtx != nil {
+		runAsUser, runAsNonRoot = podSecCtx.RunAsUser, podSecCtx.RunAsNonRoot
+	}
+	if containerSecCtx != nil {
+		if containerSecCtx.RunAsUser != nil {
+			runAsUser = containerSecCtx.RunAsUser
+		}
+		if containerSecCtx.RunAsNonRoot != nil {
+			runAsNonRoot = containerSecCtx.RunAsNonRoot
+		}
+	}
+
+	return runAsUser, runAsNonRoot
+}
+
+// Name returns the recommendation name.
+func (f *PodNoRootOrPrivileged) Name() string {
+	return podNoRootOrPrivilegedName
+}
@@ -0,0 +1,55 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/ptr"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestPodNoRootOrPrivileged_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Warnings: []string{
+			"Pod 'foo/pod-name' initContainer 'init' may run as root user. Consider setting 'runAsNonRoot: true' in its security context.",
+			"Pod 'foo/pod-name' container 'privileged' runs in privileged mode.",
+			"Pod 'foo/pod-name' container 'root' runs as root user.",
+		},
+	}
+
+	recomm := recommendation.NewPodNoRootOrPrivileged()
+
+	rootUser := int64(0)
+	pod := fixPod()
+	pod.Spec.SecurityContext = &v1.PodSecurityContext{RunAsNonRoot: ptr.Bool(true)}
+	pod.Spec.InitContainers = []v1.Container{
+		{Name: "init", SecurityContext: &v1.SecurityContext{RunAsNonRoot: ptr.Bool(false)}},
+	}
+	pod.Spec.Containers = []v1.Container{
+		{Name: "privileged", SecurityContext: &v1.SecurityContext{Privileged: ptr.Bool(true)}},
+		{Name: "root", SecurityContext: &v1.SecurityContext{RunAsUser: &rootUser}},
+		{Name: "non-root"},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := events.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods", "sample")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
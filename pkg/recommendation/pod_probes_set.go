@@ -0,0 +1,58 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+const podProbesSetName = "PodProbesSet"
+
+// PodProbesSet adds recommendations if Pod containers don't define liveness or readiness probes.
+type PodProbesSet struct{}
+
+// NewPodProbesSet creates a new PodProbesSet instance.
+func NewPodProbesSet() *PodProbesSet {
+	return &PodProbesSet{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodProbesSet) Do(_ context.Context, event events.Event) (Result, error) {
+	if !isCreatedOrUpdatedKind(event, "Pod") {
+		return Result{}, nil
+	}
+
+	pod, err := podFromEvent(event)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var infoMsgs []string
+	for _, c := range pod.Spec.Containers {
+		var missing []string
+		if c.LivenessProbe == nil {
+			missing = append(missing, "liveness")
+		}
+		if c.ReadinessProbe == nil {
+			missing = append(missing, "readiness")
+		}
+
+		if len(missing) == 0 {
+			continue
+		}
+
+		recommendationMsg := fmt.Sprintf("Pod '%s/%s' container '%s' doesn't define %s probe. Consider defining it, to let Kubernetes detect unhealthy containers.", pod.Namespace, pod.Name, c.Name, strings.Join(missing, " and "))
+		infoMsgs = append(infoMsgs, recommendationMsg)
+	}
+
+	return Result{
+		Info: infoMsgs,
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *PodProbesSet) Name() string {
+	return podProbesSetName
+}
@@ -0,0 +1,49 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestPodProbesSet_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Info: []string{
+			"Pod 'foo/pod-name' container 'first' doesn't define readiness probe. Consider defining it, to let Kubernetes detect unhealthy containers.",
+			"Pod 'foo/pod-name' container 'second' doesn't define liveness and readiness probe. Consider defining it, to let Kubernetes detect unhealthy containers.",
+		},
+	}
+
+	recomm := recommendation.NewPodProbesSet()
+
+	probe := &v1.Probe{ProbeHandler: v1.ProbeHandler{HTTPGet: &v1.HTTPGetAction{Path: "/healthz"}}}
+	pod := fixPod()
+	pod.Spec.Containers = []v1.Container{
+		{Name: "first", LivenessProbe: probe},
+		{Name: "second"},
+		{Name: "third", LivenessProbe: probe, ReadinessProbe: probe},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := events.New(pod.ObjectMeta, unstr, config.CreateEvent, "v1/pods", "sample")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
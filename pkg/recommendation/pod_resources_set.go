@@ -0,0 +1,77 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	coreV1 "k8s.io/api/core/v1"
+
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+const podResourcesSetName = "PodResourcesSet"
+
+// PodResourcesSet adds recommendations if Pod containers don't define CPU and memory requests or limits.
+type PodResourcesSet struct{}
+
+// NewPodResourcesSet creates a new PodResourcesSet instance.
+func NewPodResourcesSet() *PodResourcesSet {
+	return &PodResourcesSet{}
+}
+
+// Do executes the recommendation checks.
+func (f *PodResourcesSet) Do(_ context.Context, event events.Event) (Result, error) {
+	if !isCreatedOrUpdatedKind(event, "Pod") {
+		return Result{}, nil
+	}
+
+	pod, err := podFromEvent(event)
+	if err != nil {
+		return Result{}, err
+	}
+
+	podIdentifier := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+
+	infoMsgs := f.checkContainers("initContainer", pod.Spec.InitContainers, podIdentifier)
+	infoMsgs = append(infoMsgs, f.checkContainers("container", pod.Spec.Containers, podIdentifier)...)
+
+	return Result{
+		Info: infoMsgs,
+	}, nil
+}
+
+func (f *PodResourcesSet) checkContainers(fieldName string, containers []coreV1.Container, podIdentifier string) []string {
+	var recomms []string
+	for _, c := range containers {
+		var missing []string
+		for _, item := range []struct {
+			name string
+			list coreV1.ResourceList
+			key  coreV1.ResourceName
+		}{
+			{name: "CPU request", list: c.Resources.Requests, key: coreV1.ResourceCPU},
+			{name: "memory request", list: c.Resources.Requests, key: coreV1.ResourceMemory},
+			{name: "CPU limit", list: c.Resources.Limits, key: coreV1.ResourceCPU},
+			{name: "memory limit", list: c.Resources.Limits, key: coreV1.ResourceMemory},
+		} {
+			if _, found := item.list[item.key]; !found {
+				missing = append(missing, item.name)
+			}
+		}
+
+		if len(missing) == 0 {
+			continue
+		}
+
+		recommendationMsg := fmt.Sprintf("Pod '%s' %s '%s' doesn't define %s. Consider setting them, to make scheduling and resource usage predictable.", podIdentifier, fieldName, c.Name, strings.Join(missing, ", "))
+		recomms = append(recomms, recommendationMsg)
+	}
+
+	return recomms
+}
+
+// Name returns the recommendation name.
+func (f *PodResourcesSet) Name() string {
+	return podResourcesSetName
+}
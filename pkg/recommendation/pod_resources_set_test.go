@@ -0,0 +1,70 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestPodResourcesSet_Do_HappyPath(t *testing.T) {
+	// given
+	expected := recommendation.Result{
+		Info: []string{
+			"Pod 'foo/pod-name' container 'first' doesn't define CPU limit, memory limit. Consider setting them, to make scheduling and resource usage predictable.",
+			"Pod 'foo/pod-name' container 'second' doesn't define CPU request, memory request, CPU limit, memory limit. Consider setting them, to make scheduling and resource usage predictable.",
+		},
+	}
+
+	recomm := recommendation.NewPodResourcesSet()
+
+	pod := fixPod()
+	pod.Spec.InitContainers = nil
+	pod.Spec.Containers = []v1.Container{
+		{
+			Name: "first",
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+			},
+		},
+		{Name: "second"},
+		{
+			Name: "third",
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("64Mi"),
+				},
+				Limits: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("200m"),
+					v1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+			},
+		},
+	}
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := events.New(pod.ObjectMeta, unstr, config.UpdateEvent, "v1/pods", "sample")
+	require.NoError(t, err)
+
+	// when
+	actual, err := recomm.Do(context.Background(), event)
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
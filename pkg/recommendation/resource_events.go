@@ -7,20 +7,59 @@ import (
 )
 
 const (
-	podsResourceName    = "v1/pods"
-	ingressResourceName = "networking.k8s.io/v1/ingresses"
+	podsResourceName        = "v1/pods"
+	ingressResourceName     = "networking.k8s.io/v1/ingresses"
+	deploymentsResourceName = "apps/v1/deployments"
+	servicesResourceName    = "v1/services"
+	netPoliciesResourceName = "networking.k8s.io/v1/networkpolicies"
+	hpaResourceName         = "autoscaling/v2/horizontalpodautoscalers"
 )
 
 // ResourceEventsForConfig returns the resource event map for a given source recommendations config.
-func ResourceEventsForConfig(recCfg config.Recommendations) map[string]config.EventType {
-	resNames := make(map[string]config.EventType)
+func ResourceEventsForConfig(recCfg config.Recommendations) map[string]config.KubernetesResourceEvents {
+	resNames := make(map[string]config.KubernetesResourceEvents)
+	addEvents := func(resourceName string, eventTypes ...config.EventType) {
+		current := resNames[resourceName]
+		for _, eventType := range eventTypes {
+			if current.Contains(eventType) {
+				continue
+			}
+			current = append(current, eventType)
+		}
+		resNames[resourceName] = current
+	}
 
 	if ptr.IsTrue(recCfg.Ingress.TLSSecretValid) || ptr.IsTrue(recCfg.Ingress.BackendServiceValid) {
-		resNames[ingressResourceName] = config.CreateEvent
+		addEvents(ingressResourceName, config.CreateEvent)
 	}
 
 	if ptr.IsTrue(recCfg.Pod.NoLatestImageTag) || ptr.IsTrue(recCfg.Pod.LabelsSet) {
-		resNames[podsResourceName] = config.CreateEvent
+		addEvents(podsResourceName, config.CreateEvent)
+	}
+
+	if ptr.IsTrue(recCfg.Pod.ResourcesSet) || ptr.IsTrue(recCfg.Pod.ProbesSet) ||
+		ptr.IsTrue(recCfg.Pod.NoRootOrPrivileged) || ptr.IsTrue(recCfg.Pod.NoHostPathVolumes) {
+		addEvents(podsResourceName, config.CreateEvent, config.UpdateEvent)
+	}
+
+	if ptr.IsTrue(recCfg.Deployment.PodDisruptionBudgetSet) {
+		addEvents(deploymentsResourceName, config.CreateEvent, config.UpdateEvent)
+	}
+
+	if ptr.IsTrue(recCfg.Service.SelectorValid) || ptr.IsTrue(recCfg.Service.TargetPortValid) {
+		addEvents(servicesResourceName, config.CreateEvent)
+	}
+
+	if ptr.IsTrue(recCfg.NetworkPolicy.PodSelectorValid) {
+		addEvents(netPoliciesResourceName, config.CreateEvent)
+	}
+
+	if ptr.IsTrue(recCfg.HorizontalPodAutoscaler.ScaleTargetValid) {
+		addEvents(hpaResourceName, config.CreateEvent)
+	}
+
+	for _, custom := range recCfg.Custom {
+		addEvents(custom.Resource, config.CreateEvent)
 	}
 
 	return resNames
@@ -34,13 +73,13 @@ func ShouldIgnoreEvent(recCfg config.Recommendations, sources map[string]config.
 	}
 
 	res := ResourceEventsForConfig(recCfg)
-	recommEventType, ok := res[event.Resource]
+	recommEventTypes, ok := res[event.Resource]
 	if !ok {
 		// this event doesn't relate to recommendations, finish early
 		return false
 	}
 
-	if event.Type != recommEventType {
+	if !recommEventTypes.Contains(event.Type) {
 		// this event doesn't relate to recommendations, finish early
 		return false
 	}
@@ -16,7 +16,7 @@ func TestResourceEventsForConfig(t *testing.T) {
 	testCases := []struct {
 		Name     string
 		RecCfg   config.Recommendations
-		Expected map[string]config.EventType
+		Expected map[string]config.KubernetesResourceEvents
 	}{
 		{
 			Name: "Pod Labels Set",
@@ -25,8 +25,8 @@ func TestResourceEventsForConfig(t *testing.T) {
 					LabelsSet: ptr.Bool(true),
 				},
 			},
-			Expected: map[string]config.EventType{
-				recommendation.PodResourceName(): config.CreateEvent,
+			Expected: map[string]config.KubernetesResourceEvents{
+				recommendation.PodResourceName(): {config.CreateEvent},
 			},
 		},
 		{
@@ -36,8 +36,8 @@ func TestResourceEventsForConfig(t *testing.T) {
 					NoLatestImageTag: ptr.Bool(true),
 				},
 			},
-			Expected: map[string]config.EventType{
-				recommendation.PodResourceName(): config.CreateEvent,
+			Expected: map[string]config.KubernetesResourceEvents{
+				recommendation.PodResourceName(): {config.CreateEvent},
 			},
 		},
 		{
@@ -47,8 +47,8 @@ func TestResourceEventsForConfig(t *testing.T) {
 					BackendServiceValid: ptr.Bool(true),
 				},
 			},
-			Expected: map[string]config.EventType{
-				recommendation.IngressResourceName(): config.CreateEvent,
+			Expected: map[string]config.KubernetesResourceEvents{
+				recommendation.IngressResourceName(): {config.CreateEvent},
 			},
 		},
 		{
@@ -58,8 +58,62 @@ func TestResourceEventsForConfig(t *testing.T) {
 					TLSSecretValid: ptr.Bool(true),
 				},
 			},
-			Expected: map[string]config.EventType{
-				recommendation.IngressResourceName(): config.CreateEvent,
+			Expected: map[string]config.KubernetesResourceEvents{
+				recommendation.IngressResourceName(): {config.CreateEvent},
+			},
+		},
+		{
+			Name: "Pod create and update",
+			RecCfg: config.Recommendations{
+				Pod: config.PodRecommendations{
+					LabelsSet:    ptr.Bool(true),
+					ResourcesSet: ptr.Bool(true),
+				},
+			},
+			Expected: map[string]config.KubernetesResourceEvents{
+				recommendation.PodResourceName(): {config.CreateEvent, config.UpdateEvent},
+			},
+		},
+		{
+			Name: "Deployment Pod Disruption Budget Set",
+			RecCfg: config.Recommendations{
+				Deployment: config.DeploymentRecommendations{
+					PodDisruptionBudgetSet: ptr.Bool(true),
+				},
+			},
+			Expected: map[string]config.KubernetesResourceEvents{
+				recommendation.DeploymentResourceName(): {config.CreateEvent, config.UpdateEvent},
+			},
+		},
+		{
+			Name: "Service, NetworkPolicy and HPA",
+			RecCfg: config.Recommendations{
+				Service: config.ServiceRecommendations{
+					SelectorValid:   ptr.Bool(true),
+					TargetPortValid: ptr.Bool(true),
+				},
+				NetworkPolicy: config.NetworkPolicyRecommendations{
+					PodSelectorValid: ptr.Bool(true),
+				},
+				HorizontalPodAutoscaler: config.HorizontalPodAutoscalerRecommendations{
+					ScaleTargetValid: ptr.Bool(true),
+				},
+			},
+			Expected: map[string]config.KubernetesResourceEvents{
+				"v1/services":                             {config.CreateEvent},
+				"networking.k8s.io/v1/networkpolicies":    {config.CreateEvent},
+				"autoscaling/v2/horizontalpodautoscalers": {config.CreateEvent},
+			},
+		},
+		{
+			Name: "Custom",
+			RecCfg: config.Recommendations{
+				Custom: []config.CustomRecommendation{
+					{Name: "ReplicasSet", Resource: "apps/v1/deployments"},
+				},
+			},
+			Expected: map[string]config.KubernetesResourceEvents{
+				"apps/v1/deployments": {config.CreateEvent},
 			},
 		},
 		{
@@ -72,9 +126,9 @@ func TestResourceEventsForConfig(t *testing.T) {
 					TLSSecretValid: ptr.Bool(true),
 				},
 			},
-			Expected: map[string]config.EventType{
-				recommendation.PodResourceName():     config.CreateEvent,
-				recommendation.IngressResourceName(): config.CreateEvent,
+			Expected: map[string]config.KubernetesResourceEvents{
+				recommendation.PodResourceName():     {config.CreateEvent},
+				recommendation.IngressResourceName(): {config.CreateEvent},
 			},
 		},
 	}
@@ -0,0 +1,78 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+const serviceSelectorValidName = "ServiceSelectorValid"
+
+// ServiceSelectorValid adds recommendations if Service selector doesn't match any Pod.
+type ServiceSelectorValid struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewServiceSelectorValid creates a new ServiceSelectorValid instance.
+func NewServiceSelectorValid(dynamicCli dynamic.Interface) *ServiceSelectorValid {
+	return &ServiceSelectorValid{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *ServiceSelectorValid) Do(ctx context.Context, event events.Event) (Result, error) {
+	svc, ok, err := serviceWithSelectorFromEvent(event)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	pods, err := listPods(ctx, f.dynamicCli, svc.Namespace, labels.SelectorFromSet(svc.Spec.Selector))
+	if err != nil {
+		return Result{}, fmt.Errorf("while listing Pods: %w", err)
+	}
+
+	if len(pods) > 0 {
+		return Result{}, nil
+	}
+
+	warningMsg := fmt.Sprintf("Selector of Service '%s/%s' doesn't match any Pod.", svc.Namespace, svc.Name)
+	return Result{
+		Warnings: []string{warningMsg},
+	}, nil
+}
+
+// Name returns the recommendation name.
+func (f *ServiceSelectorValid) Name() string {
+	return serviceSelectorValidName
+}
+
+// serviceWithSelectorFromEvent returns Service from a given create event. Services without selector, such as the ExternalName ones, are skipped.
+func serviceWithSelectorFromEvent(event events.Event) (coreV1.Service, bool, error) {
+	var svc coreV1.Service
+	if event.Kind != "Service" || event.Type != config.CreateEvent || utils.GetObjectTypeMetaData(event.Object).Kind == "Event" {
+		return svc, false, nil
+	}
+
+	unstrObj, ok := event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return svc, false, fmt.Errorf("cannot convert %T into type %T", event.Object, unstrObj)
+	}
+
+	err := utils.TransformIntoTypedObject(unstrObj, &svc)
+	if err != nil {
+		return svc, false, fmt.Errorf("while transforming object type %T into type: %T: %w", event.Object, svc, err)
+	}
+
+	if svc.Spec.Type == coreV1.ServiceTypeExternalName || len(svc.Spec.Selector) == 0 {
+		return svc, false, nil
+	}
+
+	return svc, true, nil
+}
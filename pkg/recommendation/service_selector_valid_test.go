@@ -0,0 +1,117 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestServiceSelectorValid_Do(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name     string
+		Selector map[string]string
+		Expected recommendation.Result
+	}{
+		{
+			Name:     "Matching Pods",
+			Selector: map[string]string{"app": "api"},
+		},
+		{
+			Name:     "No matching Pods",
+			Selector: map[string]string{"app": "web"},
+			Expected: recommendation.Result{
+				Warnings: []string{"Selector of Service 'foo/svc-name' doesn't match any Pod."},
+			},
+		},
+		{
+			Name: "No selector",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixLabeledPod())
+			recomm := recommendation.NewServiceSelectorValid(dynamicCli)
+
+			svc := fixSelectorService(testCase.Selector, intstr.FromInt(8080))
+			event := fixServiceEvent(t, svc)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
+
+func fixServiceEvent(t *testing.T, svc *v1.Service) events.Event {
+	t.Helper()
+
+	unstrObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(svc)
+	require.NoError(t, err)
+	unstr := &unstructured.Unstructured{Object: unstrObj}
+
+	event, err := events.New(svc.ObjectMeta, unstr, config.CreateEvent, "v1/services", "sample")
+	require.NoError(t, err)
+
+	return event
+}
+
+func fixSelectorService(selector map[string]string, targetPort intstr.IntOrString) *v1.Service {
+	return &v1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "svc-name",
+			Namespace: "foo",
+		},
+		Spec: v1.ServiceSpec{
+			Selector: selector,
+			Ports: []v1.ServicePort{
+				{Port: 80, TargetPort: targetPort},
+			},
+		},
+	}
+}
+
+func fixLabeledPod() *v1.Pod {
+	return &v1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "api",
+			Namespace: "foo",
+			Labels:    map[string]string{"app": "api"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{
+					Name: "api",
+					Ports: []v1.ContainerPort{
+						{Name: "http", ContainerPort: 8080},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package recommendation
+
+import (
+	"context"
+	"fmt"
+
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+const serviceTargetPortValidName = "ServiceTargetPortValid"
+
+// ServiceTargetPortValid adds recommendations if Service target ports aren't exposed by any matching Pod.
+type ServiceTargetPortValid struct {
+	dynamicCli dynamic.Interface
+}
+
+// NewServiceTargetPortValid creates a new ServiceTargetPortValid instance.
+func NewServiceTargetPortValid(dynamicCli dynamic.Interface) *ServiceTargetPortValid {
+	return &ServiceTargetPortValid{dynamicCli: dynamicCli}
+}
+
+// Do executes the recommendation checks.
+func (f *ServiceTargetPortValid) Do(ctx context.Context, event events.Event) (Result, error) {
+	svc, ok, err := serviceWithSelectorFromEvent(event)
+	if err != nil || !ok {
+		return Result{}, err
+	}
+
+	pods, err := listPods(ctx, f.dynamicCli, svc.Namespace, labels.SelectorFromSet(svc.Spec.Selector))
+	if err != nil {
+		return Result{}, fmt.Errorf("while listing Pods: %w", err)
+	}
+
+	if len(pods) == 0 {
+		// reported by the ServiceSelectorValid recommendation
+		return Result{}, nil
+	}
+
+	var warningMsgs []string
+	for _, port := range svc.Spec.Ports {
+		targetPort := port.TargetPort
+		if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+			targetPort = intstr.FromInt(int(port.Port))
+		}
+
+		if f.anyPodExposesPort(pods, targetPort) {
+			continue
+		}
+
+		warningMsgs = append(warningMsgs, fmt.Sprintf("Target port '%s' of Service '%s/%s' is not exposed by any matching Pod.", targetPort.String(), svc.Namespace, svc.Name))
+	}
+
+	return Result{
+		Warnings: warningMsgs,
+	}, nil
+}
+
+// anyPodExposesPort returns true if any Pod exposes a given port. As declaring container ports is optional,
+// Pods without any declared port are considered to expose all numeric ports.
+func (f *ServiceTargetPortValid) anyPodExposesPort(pods []coreV1.Pod, targetPort intstr.IntOrString) bool {
+	for _, pod := range pods {
+		declared := false
+		for _, c := range pod.Spec.Containers {
+			for _, p := range c.Ports {
+				declared = true
+				if targetPort.Type == intstr.String && p.Name == targetPort.StrVal {
+					return true
+				}
+				if targetPort.Type == intstr.Int && p.ContainerPort == targetPort.IntVal {
+					return true
+				}
+			}
+		}
+
+		if !declared && targetPort.Type == intstr.Int {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Name returns the recommendation name.
+func (f *ServiceTargetPortValid) Name() string {
+	return serviceTargetPortValidName
+}
@@ -0,0 +1,68 @@
+package recommendation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/kubeshop/botkube/pkg/recommendation"
+)
+
+func TestServiceTargetPortValid_Do(t *testing.T) {
+	// given
+	testCases := []struct {
+		Name       string
+		TargetPort intstr.IntOrString
+		Expected   recommendation.Result
+	}{
+		{
+			Name:       "Numeric port exposed",
+			TargetPort: intstr.FromInt(8080),
+		},
+		{
+			Name:       "Named port exposed",
+			TargetPort: intstr.FromString("http"),
+		},
+		{
+			Name:       "Numeric port not exposed",
+			TargetPort: intstr.FromInt(9090),
+			Expected: recommendation.Result{
+				Warnings: []string{"Target port '9090' of Service 'foo/svc-name' is not exposed by any matching Pod."},
+			},
+		},
+		{
+			Name:       "Named port not exposed",
+			TargetPort: intstr.FromString("metrics"),
+			Expected: recommendation.Result{
+				Warnings: []string{"Target port 'metrics' of Service 'foo/svc-name' is not exposed by any matching Pod."},
+			},
+		},
+		{
+			Name: "Default target port",
+			Expected: recommendation.Result{
+				Warnings: []string{"Target port '80' of Service 'foo/svc-name' is not exposed by any matching Pod."},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, fixLabeledPod())
+			recomm := recommendation.NewServiceTargetPortValid(dynamicCli)
+
+			svc := fixSelectorService(map[string]string{"app": "api"}, testCase.TargetPort)
+			event := fixServiceEvent(t, svc)
+
+			// when
+			actual, err := recomm.Do(context.Background(), event)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.Expected, actual)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package schedule
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/digest"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/sliceutil"
+)
+
+const (
+	clusterScopedNamespace = "(cluster-scoped)"
+	noEventsMsg            = "No events."
+)
+
+// sourcesAggregate holds events recorded for a given set of sources.
+type sourcesAggregate struct {
+	sources   []string
+	aggregate *digest.Aggregate
+}
+
+// DigestSnapshot holds events counted since the last schedule run.
+type DigestSnapshot struct {
+	Since     time.Time
+	bySources map[string]sourcesAggregate
+}
+
+// Render renders events counted per namespace and level for a given source bindings.
+// Events are aggregated per their set of sources, so each event is counted once even if it matches many bound sources.
+func (s DigestSnapshot) Render(sources []string) string {
+	merged := digest.New(s.Since)
+	for _, item := range s.bySources {
+		if !sliceutil.Intersect(sources, item.sources) {
+			continue
+		}
+		merged.Merge(item.aggregate)
+	}
+
+	counts := merged.NamespaceLevels()
+	if len(counts) == 0 {
+		return noEventsMsg
+	}
+
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 5, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tLEVEL\tCOUNT")
+	for _, item := range counts {
+		namespace := item.Key.Namespace
+		if namespace == "" {
+			namespace = clusterScopedNamespace
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\n", namespace, item.Key.Level, item.Count)
+	}
+	w.Flush()
+
+	return buf.String()
+}
+
+// EventDigest counts events per namespace and level for schedules with the event digest enabled.
+type EventDigest struct {
+	mu        sync.Mutex
+	snapshots map[string]DigestSnapshot
+	now       func() time.Time
+}
+
+// NewEventDigest returns a new EventDigest instance.
+func NewEventDigest(schedules map[string]config.Schedule) *EventDigest {
+	d := &EventDigest{
+		snapshots: map[string]DigestSnapshot{},
+		now:       time.Now,
+	}
+
+	for name, schedule := range schedules {
+		if !schedule.EventDigest.Enabled {
+			continue
+		}
+		d.snapshots[name] = d.newSnapshot()
+	}
+	return d
+}
+
+// Record counts a given event for all schedules with the event digest enabled.
+func (d *EventDigest) Record(event events.Event, sources []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	sortedSources := append([]string(nil), sources...)
+	sort.Strings(sortedSources)
+	key := strings.Join(sortedSources, ",")
+
+	for _, snapshot := range d.snapshots {
+		item, found := snapshot.bySources[key]
+		if !found {
+			item = sourcesAggregate{sources: sortedSources, aggregate: digest.New(snapshot.Since)}
+			snapshot.bySources[key] = item
+		}
+		item.aggregate.Add(event)
+	}
+}
+
+// Flush returns events counted for a given schedule and starts counting from scratch.
+func (d *EventDigest) Flush(scheduleName string) DigestSnapshot {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	snapshot, found := d.snapshots[scheduleName]
+	if !found {
+		return DigestSnapshot{}
+	}
+
+	d.snapshots[scheduleName] = d.newSnapshot()
+	return snapshot
+}
+
+func (d *EventDigest) newSnapshot() DigestSnapshot {
+	return DigestSnapshot{
+		Since:     d.now(),
+		bySources: map[string]sourcesAggregate{},
+	}
+}
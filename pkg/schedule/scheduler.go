@@ -0,0 +1,170 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+	"github.com/kubeshop/botkube/pkg/multierror"
+)
+
+const (
+	commandNotSupportedMsgFmt = "Sorry, the command is not enabled for this channel on cluster '%s'."
+	commandErrorMsgFmt        = "Sorry, an internal error occurred while executing the command for the '%s' cluster :( See the logs for more details."
+)
+
+// Channel holds details of a channel bound to a given schedule.
+type Channel struct {
+	Alias    string
+	ID       string
+	Bindings config.BotBindings
+}
+
+// ReportRenderer renders a scheduled report for a given channel.
+type ReportRenderer func(channel Channel) interactive.Message
+
+// Bot sends scheduled reports to channels bound to a given schedule.
+type Bot interface {
+	SendScheduledReport(ctx context.Context, scheduleName string, render ReportRenderer) error
+}
+
+// KubectlExecutor executes kubectl commands with the permissions of a given channel.
+type KubectlExecutor interface {
+	CanHandle(bindings []string, args []string) bool
+	Execute(bindings []string, command string, isAuthChannel bool) (string, error)
+}
+
+// Scheduler runs configured schedules and sends reports to bound channels.
+type Scheduler struct {
+	log         logrus.FieldLogger
+	schedules   map[string]config.Schedule
+	clusterName string
+	kcExecutor  KubectlExecutor
+	bots        []Bot
+	digest      *EventDigest
+}
+
+// NewScheduler returns a new Scheduler instance.
+func NewScheduler(log logrus.FieldLogger, cfg config.Config, kcExecutor KubectlExecutor, bots []Bot) *Scheduler {
+	return &Scheduler{
+		log:         log,
+		schedules:   cfg.Schedules,
+		clusterName: cfg.Settings.ClusterName,
+		kcExecutor:  kcExecutor,
+		bots:        bots,
+		digest:      NewEventDigest(cfg.Schedules),
+	}
+}
+
+// RecordEvent records a given event for schedules with the event digest enabled.
+func (s *Scheduler) RecordEvent(event events.Event, sources []string) {
+	s.digest.Record(event, sources)
+}
+
+// Start starts all schedules and blocks until the context is cancelled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	if len(s.schedules) == 0 {
+		s.log.Info("No schedules configured. Skipping...")
+		return nil
+	}
+
+	s.log.Info("Starting scheduler...")
+	c := cron.New()
+	for name, schedule := range s.schedules {
+		name, schedule := name, schedule
+		_, err := c.AddFunc(schedule.Cron, func() {
+			s.run(ctx, name, schedule)
+		})
+		if err != nil {
+			// The cron expressions are validated when the configuration is loaded, so don't stop other schedules and components.
+			s.log.WithField("schedule", name).Errorf("while adding schedule with cron %q: %s. Skipping...", schedule.Cron, err.Error())
+		}
+	}
+
+	c.Start()
+	<-ctx.Done()
+
+	s.log.Info("Shutdown requested. Stopping scheduler...")
+	<-c.Stop().Done()
+	return nil
+}
+
+func (s *Scheduler) run(ctx context.Context, name string, schedule config.Schedule) {
+	log := s.log.WithField("schedule", name)
+	log.Debug("Running schedule...")
+
+	snapshot := s.digest.Flush(name)
+	render := func(channel Channel) interactive.Message {
+		return s.renderReport(name, schedule, channel, snapshot)
+	}
+
+	errs := multierror.New()
+	for _, bot := range s.bots {
+		if err := bot.SendScheduledReport(ctx, name, render); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		log.Errorf("while sending scheduled report: %s", err.Error())
+	}
+}
+
+func (s *Scheduler) renderReport(name string, schedule config.Schedule, channel Channel, snapshot DigestSnapshot) interactive.Message {
+	msg := interactive.Message{
+		Base: interactive.Base{
+			Header:      fmt.Sprintf("Scheduled report %q", name),
+			Description: fmt.Sprintf("Report for the `%s` cluster", s.clusterName),
+		},
+	}
+
+	for _, command := range schedule.Commands {
+		msg.Sections = append(msg.Sections, interactive.Section{
+			Base: interactive.Base{
+				Description: fmt.Sprintf("`%s`", command),
+				Body: interactive.Body{
+					CodeBlock: s.executeCommand(channel, command),
+				},
+			},
+		})
+	}
+
+	if schedule.EventDigest.Enabled {
+		msg.Sections = append(msg.Sections, interactive.Section{
+			Base: interactive.Base{
+				Description: fmt.Sprintf("Events since %s", snapshot.Since.UTC().Format(time.RFC1123)),
+				Body: interactive.Body{
+					CodeBlock: snapshot.Render(channel.Bindings.Sources),
+				},
+			},
+		})
+	}
+
+	return msg
+}
+
+// executeCommand runs a given command through the Kubectl executor, so the channel executor bindings are respected.
+func (s *Scheduler) executeCommand(channel Channel, command string) string {
+	args := strings.Fields(strings.TrimSpace(command))
+	if !s.kcExecutor.CanHandle(channel.Bindings.Executors, args) {
+		return fmt.Sprintf(commandNotSupportedMsgFmt, s.clusterName)
+	}
+
+	out, err := s.kcExecutor.Execute(channel.Bindings.Executors, command, true)
+	if err != nil {
+		s.log.WithFields(logrus.Fields{
+			"command": command,
+			"channel": channel.Alias,
+		}).Errorf("while executing scheduled command: %s", err.Error())
+		return fmt.Sprintf(commandErrorMsgFmt, s.clusterName)
+	}
+
+	return out
+}
@@ -0,0 +1,182 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/bot/interactive"
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
+)
+
+func TestScheduler_Run(t *testing.T) {
+	// given
+	log, _ := logtest.NewNullLogger()
+	cfg := config.Config{
+		Settings: config.Settings{ClusterName: "prod"},
+		Schedules: map[string]config.Schedule{
+			"daily": {
+				Cron: "@daily",
+				Commands: []string{
+					"kubectl get pods -A",
+					"kubectl delete pods foo",
+					"kubectl get nodes",
+				},
+				EventDigest: config.ScheduleEventDigest{Enabled: true},
+			},
+		},
+	}
+	kcExecutor := &fakeKubectlExecutor{
+		allowed: map[string]string{
+			"kubectl get pods -A": "NAME   READY\nfoo    1/1",
+		},
+		failing: map[string]struct{}{
+			"kubectl get nodes": {},
+		},
+	}
+	bot := &fakeBot{
+		channels: []Channel{
+			{
+				Alias:    "alerts",
+				ID:       "C123",
+				Bindings: config.BotBindings{Sources: []string{"k8s-err-events"}, Executors: []string{"kubectl-read-only"}},
+			},
+		},
+	}
+
+	scheduler := NewScheduler(log, cfg, kcExecutor, []Bot{bot})
+	scheduler.RecordEvent(events.Event{Namespace: "default", Level: config.Error}, []string{"k8s-err-events"})
+	scheduler.RecordEvent(events.Event{Namespace: "default", Level: config.Error}, []string{"k8s-err-events"})
+	scheduler.RecordEvent(events.Event{Level: config.Critical}, []string{"k8s-err-events"})
+	scheduler.RecordEvent(events.Event{Namespace: "default", Level: config.Info}, []string{"k8s-create-events"})
+
+	// when
+	scheduler.run(context.Background(), "daily", cfg.Schedules["daily"])
+
+	// then
+	require.Len(t, bot.sent, 1)
+	msg := bot.sent[0]
+	assert.Equal(t, `Scheduled report "daily"`, msg.Header)
+	require.Len(t, msg.Sections, 4)
+	assert.Equal(t, "NAME   READY\nfoo    1/1", msg.Sections[0].Body.CodeBlock)
+	assert.Equal(t, "Sorry, the command is not enabled for this channel on cluster 'prod'.", msg.Sections[1].Body.CodeBlock)
+	assert.Equal(t, "Sorry, an internal error occurred while executing the command for the 'prod' cluster :( See the logs for more details.", msg.Sections[2].Body.CodeBlock)
+	assert.Equal(t, heredoc.Doc(`
+		NAMESPACE        LEVEL    COUNT
+		(cluster-scoped) critical 1
+		default          error    2
+	`), msg.Sections[3].Body.CodeBlock)
+
+	// when
+	scheduler.run(context.Background(), "daily", cfg.Schedules["daily"])
+
+	// then
+	require.Len(t, bot.sent, 2)
+	assert.Equal(t, "No events.", bot.sent[1].Sections[3].Body.CodeBlock)
+}
+
+func TestScheduler_StartSkipsInvalidCron(t *testing.T) {
+	// given
+	log, _ := logtest.NewNullLogger()
+	cfg := config.Config{
+		Schedules: map[string]config.Schedule{
+			"invalid": {Cron: "0 25 * *"},
+			"daily":   {Cron: "@daily"},
+		},
+	}
+	scheduler := NewScheduler(log, cfg, &fakeKubectlExecutor{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// when
+	err := scheduler.Start(ctx)
+
+	// then
+	assert.NoError(t, err)
+}
+
+func TestEventDigest_IgnoresSchedulesWithoutDigest(t *testing.T) {
+	// given
+	now := time.Date(2022, 9, 1, 9, 0, 0, 0, time.UTC)
+	digest := NewEventDigest(map[string]config.Schedule{
+		"with-digest":    {EventDigest: config.ScheduleEventDigest{Enabled: true}},
+		"without-digest": {},
+	})
+	digest.now = func() time.Time { return now }
+
+	// when
+	digest.Record(events.Event{Namespace: "default", Level: config.Error}, []string{"k8s-err-events"})
+	withDigest := digest.Flush("with-digest")
+	withoutDigest := digest.Flush("without-digest")
+
+	// then
+	assert.Equal(t, heredoc.Doc(`
+		NAMESPACE LEVEL COUNT
+		default   error 1
+	`), withDigest.Render([]string{"k8s-err-events"}))
+	assert.Equal(t, "No events.", withDigest.Render([]string{"other"}))
+	assert.Equal(t, "No events.", withoutDigest.Render([]string{"k8s-err-events"}))
+	assert.Equal(t, now, digest.Flush("with-digest").Since)
+}
+
+type fakeBot struct {
+	channels []Channel
+	sent     []interactive.Message
+}
+
+func (f *fakeBot) SendScheduledReport(_ context.Context, _ string, render ReportRenderer) error {
+	for _, channel := range f.channels {
+		f.sent = append(f.sent, render(channel))
+	}
+	return nil
+}
+
+type fakeKubectlExecutor struct {
+	allowed map[string]string
+	failing map[string]struct{}
+}
+
+func (f *fakeKubectlExecutor) CanHandle(_ []string, args []string) bool {
+	cmd := strings.Join(args, " ")
+	_, allowed := f.allowed[cmd]
+	_, failing := f.failing[cmd]
+	return allowed || failing
+}
+
+func (f *fakeKubectlExecutor) Execute(_ []string, command string, _ bool) (string, error) {
+	if _, failing := f.failing[command]; failing {
+		return "", errors.New("command failed")
+	}
+	return f.allowed[command], nil
+}
+
+func TestEventDigest_CountsEventOncePerChannel(t *testing.T) {
+	// given
+	digest := NewEventDigest(map[string]config.Schedule{
+		"with-digest": {EventDigest: config.ScheduleEventDigest{Enabled: true}},
+	})
+
+	// when
+	digest.Record(events.Event{Namespace: "default", Level: config.Error}, []string{"k8s-err-events", "k8s-all-events"})
+	digest.Record(events.Event{Namespace: "default", Level: config.Error}, []string{"k8s-all-events"})
+	snapshot := digest.Flush("with-digest")
+
+	// then
+	assert.Equal(t, heredoc.Doc(`
+		NAMESPACE LEVEL COUNT
+		default   error 2
+	`), snapshot.Render([]string{"k8s-err-events", "k8s-all-events"}))
+	assert.Equal(t, heredoc.Doc(`
+		NAMESPACE LEVEL COUNT
+		default   error 1
+	`), snapshot.Render([]string{"k8s-err-events"}))
+}
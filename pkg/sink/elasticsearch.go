@@ -183,7 +183,12 @@ func (e *Elasticsearch) SendEvent(ctx context.Context, event events.Event, event
 			continue
 		}
 
-		err := e.flushIndex(ctx, indexCfg, event)
+		if !event.Level.IsAtLeast(indexCfg.Bindings.MinLevel) {
+			e.log.Debugf("Skipping event for Elasticsearch index %q as the event level %q is lower than %q.", indexCfg.Name, event.Level, indexCfg.Bindings.MinLevel)
+			continue
+		}
+
+		err := e.flushIndex(ctx, indexCfg, event.ForSources(indexCfg.Bindings.Sources))
 		if err != nil {
 			errs = multierror.Append(errs, fmt.Errorf("while sending event to Elasticsearch index %q: %w", indexCfg.Name, err))
 			continue
@@ -80,6 +80,12 @@ func (w *Webhook) SendEvent(ctx context.Context, event events.Event, eventSource
 		return nil
 	}
 
+	if !event.Level.IsAtLeast(w.Bindings.MinLevel) {
+		w.log.Debugf("Event level %q is lower than Webhook minimum level %q, event: %+v", event.Level, w.Bindings.MinLevel, event)
+		return nil
+	}
+	event = event.ForSources(w.Bindings.Sources)
+
 	jsonPayload := &WebhookPayload{
 		EventMeta: EventMeta{
 			Kind:      event.Kind,
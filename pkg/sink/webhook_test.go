@@ -7,7 +7,12 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/events"
 )
 
 // Unit test PostWebhook
@@ -44,3 +49,31 @@ func TestPostWebhook(t *testing.T) {
 		})
 	}
 }
+
+func TestWebhook_SendEventRespectsMinLevel(t *testing.T) {
+	// given
+	var received int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	w := &Webhook{
+		log: logrus.New(),
+		URL: ts.URL,
+		Bindings: config.SinkBindings{
+			Sources:  []string{"k8s-events"},
+			MinLevel: config.Warn,
+		},
+	}
+
+	// when
+	for _, level := range []config.Level{config.Debug, config.Info, config.Warn, config.Error, config.Critical} {
+		err := w.SendEvent(context.Background(), events.Event{Level: level}, []string{"k8s-events"})
+		require.NoError(t, err)
+	}
+
+	// then
+	assert.Equal(t, 3, received)
+}
@@ -0,0 +1,252 @@
+package sources
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+const (
+	conditionStatusTrue  = "True"
+	conditionStatusFalse = "False"
+)
+
+// ConditionTransition describes a status condition change which qualifies as an error or its resolution.
+type ConditionTransition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// EventType returns the event type which is reported for a given transition.
+func (t ConditionTransition) EventType() config.EventType {
+	if t.Status == conditionStatusTrue {
+		return config.ResolvedEvent
+	}
+	return config.ErrorEvent
+}
+
+type conditionEventHandler func(ctx context.Context, resource string, sources []string, transition ConditionTransition) func(obj interface{})
+
+// HandleConditionEvent registers informers for resources which have status conditions configured,
+// and triggers a given handler when the condition status changes to False, or back to True.
+// The handler is registered only once per informer.
+func (r *Router) HandleConditionEvent(ctx context.Context, handler registrationHandler, handlerFn conditionEventHandler) error {
+	for _, resource := range r.resourcesWithConditions() {
+		reg, exists := r.registrations[resource]
+		if !exists {
+			informer, err := handler(resource)
+			if err != nil {
+				return err
+			}
+			reg = r.newRegistration(informer)
+			r.registrations[resource] = reg
+		}
+
+		if reg.conditionsHandled {
+			continue
+		}
+		reg.handleConditions(ctx, resource, handlerFn)
+	}
+	return nil
+}
+
+func (r *registration) handleConditions(ctx context.Context, resource string, fn conditionEventHandler) {
+	r.conditionsHandled = true
+	statuses := newConditionStatuses()
+
+	r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if unstrObj, ok := obj.(*unstructured.Unstructured); ok {
+				statuses.forget(unstrObj.GetUID())
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			routes := r.routes(resource, config.ErrorEvent)
+			for _, transition := range conditionTransitions(oldObj, newObj, routes, statuses) {
+				sources, err := sourcesForObjNamespace(ctx, routesForCondition(routes, transition.Type), newObj, r.log, r.mapper, r.dynamicCli, r.workloadResolver)
+				if err != nil {
+					r.log.WithFields(logrus.Fields{
+						"eventHandler": transition.EventType(),
+						"resource":     resource,
+						"error":        err.Error(),
+					}).Errorf("Cannot calculate sources for observed resource condition.")
+					continue
+				}
+				r.log.Debugf("handle condition %q transition to %q, resource: %q, sources: %+v", transition.Type, transition.Status, resource, sources)
+				if len(sources) > 0 {
+					fn(ctx, resource, sources, transition)(newObj)
+				}
+			}
+		},
+	})
+}
+
+func (r *Router) resourcesWithConditions() []string {
+	var out []string
+	table := r.getTable()
+	for resource := range table {
+		for _, route := range sourceRoutes(table, resource, config.ErrorEvent) {
+			if len(route.conditions) > 0 {
+				out = append(out, resource)
+				break
+			}
+		}
+	}
+	return out
+}
+
+func routesForCondition(routes []route, conditionType string) []route {
+	var out []route
+	for _, route := range routes {
+		for _, cond := range route.conditions {
+			if cond.Type == conditionType {
+				out = append(out, route)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// conditionStatuses holds the last definitive, True or False, status of the object conditions.
+// Many controllers, such as Flux or Argo CD, set the Unknown status while reconciling,
+// so the transitions are qualified based on the definitive statuses only.
+type conditionStatuses struct {
+	mu sync.Mutex
+	// statuses are indexed by the object UID and the condition type.
+	statuses map[types.UID]map[string]string
+}
+
+func newConditionStatuses() *conditionStatuses {
+	return &conditionStatuses{
+		statuses: map[types.UID]map[string]string{},
+	}
+}
+
+// transition records a given condition status change and returns true if it qualifies as an error or its resolution.
+func (s *conditionStatuses) transition(uid types.UID, condType, oldStatus, newStatus string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last, found := s.statuses[uid][condType]
+	if !found && isDefinitiveStatus(oldStatus) {
+		last = oldStatus
+	}
+
+	if !isDefinitiveStatus(newStatus) {
+		if last != "" {
+			s.set(uid, condType, last)
+		}
+		return false
+	}
+
+	s.set(uid, condType, newStatus)
+	return isQualifiedTransition(last, newStatus)
+}
+
+func (s *conditionStatuses) set(uid types.UID, condType, status string) {
+	if _, ok := s.statuses[uid]; !ok {
+		s.statuses[uid] = map[string]string{}
+	}
+	s.statuses[uid][condType] = status
+}
+
+// forget removes the statuses of a deleted object.
+func (s *conditionStatuses) forget(uid types.UID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.statuses, uid)
+}
+
+// conditionTransitions returns the tracked conditions which changed to False, or from False back to True.
+// The Unknown status in between is ignored, e.g. False -> Unknown -> True is reported as the resolution.
+func conditionTransitions(oldObj, newObj interface{}, routes []route, statuses *conditionStatuses) []ConditionTransition {
+	oldUnstruct, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	newUnstruct, ok := newObj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	oldConditions := statusConditions(oldUnstruct)
+	newConditions := statusConditions(newUnstruct)
+
+	var out []ConditionTransition
+	seen := map[string]struct{}{}
+	for _, route := range routes {
+		for _, cond := range route.conditions {
+			if _, ok := seen[cond.Type]; ok {
+				continue
+			}
+			seen[cond.Type] = struct{}{}
+
+			newCond, found := newConditions[cond.Type]
+			if !found || !statuses.transition(newUnstruct.GetUID(), cond.Type, oldConditions[cond.Type].Status, newCond.Status) {
+				continue
+			}
+			out = append(out, newCond)
+		}
+	}
+	return out
+}
+
+// isQualifiedTransition returns true if the condition status changed to False, or from False back to True.
+func isQualifiedTransition(oldStatus, newStatus string) bool {
+	switch newStatus {
+	case conditionStatusFalse:
+		return oldStatus != conditionStatusFalse
+	case conditionStatusTrue:
+		return oldStatus == conditionStatusFalse
+	}
+	return false
+}
+
+func isDefinitiveStatus(status string) bool {
+	return status == conditionStatusTrue || status == conditionStatusFalse
+}
+
+// statusConditions returns the `status.conditions` of a given object indexed by the condition type.
+func statusConditions(obj *unstructured.Unstructured) map[string]ConditionTransition {
+	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	out := make(map[string]ConditionTransition)
+	for _, item := range conditions {
+		cond, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		condType, _, _ := unstructured.NestedString(cond, "type")
+		if condType == "" {
+			continue
+		}
+		status, _, _ := unstructured.NestedString(cond, "status")
+		reason, _, _ := unstructured.NestedString(cond, "reason")
+		message, _, _ := unstructured.NestedString(cond, "message")
+
+		out[condType] = ConditionTransition{
+			Type:    condType,
+			Status:  status,
+			Reason:  reason,
+			Message: message,
+		}
+	}
+	return out
+}
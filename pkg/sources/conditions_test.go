@@ -0,0 +1,201 @@
+package sources
+
+import (
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestConditionTransitions(t *testing.T) {
+	// given
+	routes := []route{
+		{source: "certs", conditions: []config.ResourceCondition{{Type: "Ready"}}},
+		{source: "certs-issuing", conditions: []config.ResourceCondition{{Type: "Ready"}, {Type: "Issuing"}}},
+	}
+
+	tests := []struct {
+		name     string
+		oldObj   interface{}
+		newObj   interface{}
+		expected []ConditionTransition
+	}{
+		{
+			name:   "Condition changed to False",
+			oldObj: fixObjWithConditions(fixCondition("Ready", "True", "Ready", "Certificate is up to date")),
+			newObj: fixObjWithConditions(fixCondition("Ready", "False", "Expired", "Certificate has expired")),
+			expected: []ConditionTransition{
+				{Type: "Ready", Status: "False", Reason: "Expired", Message: "Certificate has expired"},
+			},
+		},
+		{
+			name:   "Condition added with False status",
+			oldObj: fixObjWithConditions(),
+			newObj: fixObjWithConditions(fixCondition("Ready", "False", "DoesNotExist", "Issuing certificate")),
+			expected: []ConditionTransition{
+				{Type: "Ready", Status: "False", Reason: "DoesNotExist", Message: "Issuing certificate"},
+			},
+		},
+		{
+			name:   "Condition changed back to True",
+			oldObj: fixObjWithConditions(fixCondition("Ready", "False", "Expired", "Certificate has expired")),
+			newObj: fixObjWithConditions(fixCondition("Ready", "True", "Ready", "Certificate is up to date")),
+			expected: []ConditionTransition{
+				{Type: "Ready", Status: "True", Reason: "Ready", Message: "Certificate is up to date"},
+			},
+		},
+		{
+			name:   "Condition still False",
+			oldObj: fixObjWithConditions(fixCondition("Ready", "False", "Expired", "Certificate has expired")),
+			newObj: fixObjWithConditions(fixCondition("Ready", "False", "Expired", "Certificate has expired for 2 days")),
+		},
+		{
+			name:   "Condition changed from Unknown to True",
+			oldObj: fixObjWithConditions(fixCondition("Ready", "Unknown", "", "")),
+			newObj: fixObjWithConditions(fixCondition("Ready", "True", "Ready", "Certificate is up to date")),
+		},
+		{
+			name:   "Not tracked condition changed to False",
+			oldObj: fixObjWithConditions(fixCondition("Stalled", "True", "", "")),
+			newObj: fixObjWithConditions(fixCondition("Stalled", "False", "", "")),
+		},
+		{
+			name: "Multiple conditions changed",
+			oldObj: fixObjWithConditions(
+				fixCondition("Ready", "True", "", ""),
+				fixCondition("Issuing", "True", "", ""),
+			),
+			newObj: fixObjWithConditions(
+				fixCondition("Ready", "False", "Expired", ""),
+				fixCondition("Issuing", "False", "Failed", ""),
+			),
+			expected: []ConditionTransition{
+				{Type: "Ready", Status: "False", Reason: "Expired"},
+				{Type: "Issuing", Status: "False", Reason: "Failed"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := conditionTransitions(tc.oldObj, tc.newObj, routes, newConditionStatuses())
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestConditionTransitionsIgnoreUnknownStatus(t *testing.T) {
+	// given
+	routes := []route{
+		{source: "flux", conditions: []config.ResourceCondition{{Type: "Ready"}}},
+	}
+	ready := fixObjWithConditions(fixCondition("Ready", "True", "Succeeded", ""))
+	failed := fixObjWithConditions(fixCondition("Ready", "False", "Failed", ""))
+	reconciling := fixObjWithConditions(fixCondition("Ready", "Unknown", "Progressing", ""))
+
+	tests := []struct {
+		name        string
+		objects     []*unstructured.Unstructured
+		expStatuses []string
+	}{
+		{
+			name:        "Resolved after Unknown",
+			objects:     []*unstructured.Unstructured{ready, failed, reconciling, ready},
+			expStatuses: []string{"False", "True"},
+		},
+		{
+			name:        "Still failing after Unknown",
+			objects:     []*unstructured.Unstructured{ready, failed, reconciling, failed, reconciling, failed},
+			expStatuses: []string{"False"},
+		},
+		{
+			name:        "Ready after Unknown",
+			objects:     []*unstructured.Unstructured{ready, reconciling, ready},
+			expStatuses: nil,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			statuses := newConditionStatuses()
+
+			// when
+			var actual []string
+			for i := 1; i < len(tc.objects); i++ {
+				for _, transition := range conditionTransitions(tc.objects[i-1], tc.objects[i], routes, statuses) {
+					actual = append(actual, transition.Status)
+				}
+			}
+
+			// then
+			assert.Equal(t, tc.expStatuses, actual)
+		})
+	}
+}
+
+func TestRouter_ResourcesWithConditions(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	cfg := &config.Config{
+		Sources: map[string]config.Sources{
+			"k8s-events": {
+				Kubernetes: config.KubernetesSource{
+					Events: []config.EventType{config.ErrorEvent},
+					Resources: []config.Resource{
+						{Name: "v1/pods"},
+						{
+							Name:       "cert-manager.io/v1/certificates",
+							Conditions: []config.ResourceCondition{{Type: "Ready"}},
+						},
+						{
+							Name:       "kustomize.toolkit.fluxcd.io/v1beta2/kustomizations",
+							Events:     []config.EventType{config.CreateEvent},
+							Conditions: []config.ResourceCondition{{Type: "Ready"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	router := NewRouter(nil, nil, logger).
+		AddAnyBindings(config.BotBindings{Sources: []string{"k8s-events"}}).
+		BuildTable(cfg)
+
+	// when
+	resources := router.resourcesWithConditions()
+
+	// then
+	assert.Equal(t, []string{"cert-manager.io/v1/certificates"}, resources)
+	assert.Len(t, routesForCondition(router.getSourceRoutes("cert-manager.io/v1/certificates", config.ErrorEvent), "Ready"), 1)
+	assert.Empty(t, routesForCondition(router.getSourceRoutes("cert-manager.io/v1/certificates", config.ErrorEvent), "Issuing"))
+}
+
+func fixObjWithConditions(conditions ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "cert-manager.io/v1",
+			"kind":       "Certificate",
+			"metadata": map[string]interface{}{
+				"name":      "api-tls",
+				"namespace": "team-a",
+			},
+			"status": map[string]interface{}{
+				"conditions": conditions,
+			},
+		},
+	}
+}
+
+func fixCondition(condType, status, reason, message string) interface{} {
+	return map[string]interface{}{
+		"type":    condType,
+		"status":  status,
+		"reason":  reason,
+		"message": message,
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/sirupsen/logrus"
-	coreV1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/dynamic"
@@ -14,24 +13,42 @@ import (
 
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/utils"
+	"github.com/kubeshop/botkube/pkg/workload"
 )
 
+type routesGetter func(resource string, targetEvent config.EventType) []route
+
+// registration holds the informer of a given resource.
+// The routes are resolved when the informer event is observed, so the routing table can be rebuilt at runtime.
 type registration struct {
-	informer        cache.SharedIndexInformer
-	log             logrus.FieldLogger
-	mapper          meta.RESTMapper
-	dynamicCli      dynamic.Interface
-	events          []config.EventType
-	mappedResources []string
-	mappedEvent     config.EventType
+	informer   cache.SharedIndexInformer
+	log        logrus.FieldLogger
+	mapper     meta.RESTMapper
+	dynamicCli dynamic.Interface
+	// workloadResolver is shared by all registrations, so the owners are cached across resources.
+	workloadResolver *workload.Resolver
+	routes           routesGetter
+	events           []config.EventType
+	mappedEvent      config.EventType
+
+	// handled contains the event types with handlers already added to the informer.
+	handled           map[config.EventType]struct{}
+	conditionsHandled bool
 }
 
-func (r registration) handleEvent(ctx context.Context, resource string, target config.EventType, sourceRoutes []route, fn eventHandler) {
+func (r *registration) isHandled(target config.EventType) bool {
+	_, ok := r.handled[target]
+	return ok
+}
+
+func (r *registration) handleEvent(ctx context.Context, resource string, target config.EventType, fn eventHandler) {
+	r.handled[target] = struct{}{}
+
 	switch target {
 	case config.CreateEvent:
 		r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj interface{}) {
-				sources, err := sourcesForObjNamespace(ctx, sourceRoutes, obj, r.log, r.mapper, r.dynamicCli)
+				sources, err := sourcesForObjNamespace(ctx, r.routes(resource, target), obj, r.log, r.mapper, r.dynamicCli, r.workloadResolver)
 				if err != nil {
 					r.log.WithFields(logrus.Fields{
 						"eventHandler": config.CreateEvent,
@@ -42,14 +59,14 @@ func (r registration) handleEvent(ctx context.Context, resource string, target c
 				}
 				r.log.Debugf("handle Create event, resource: %q, sources: %+v", resource, sources)
 				if len(sources) > 0 {
-					fn(ctx, resource, sources, nil)(obj)
+					fn(ctx, resource, sources, nil, nil)(obj)
 				}
 			},
 		})
 	case config.DeleteEvent:
 		r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			DeleteFunc: func(obj interface{}) {
-				sources, err := sourcesForObjNamespace(ctx, sourceRoutes, obj, r.log, r.mapper, r.dynamicCli)
+				sources, err := sourcesForObjNamespace(ctx, r.routes(resource, target), obj, r.log, r.mapper, r.dynamicCli, r.workloadResolver)
 				if err != nil {
 					r.log.WithFields(logrus.Fields{
 						"eventHandler": config.DeleteEvent,
@@ -60,14 +77,14 @@ func (r registration) handleEvent(ctx context.Context, resource string, target c
 				}
 				r.log.Debugf("handle Delete event, resource: %q, sources: %+v", resource, sources)
 				if len(sources) > 0 {
-					fn(ctx, resource, sources, nil)(obj)
+					fn(ctx, resource, sources, nil, nil)(obj)
 				}
 			},
 		})
 	case config.UpdateEvent:
 		r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 			UpdateFunc: func(oldObj, newObj interface{}) {
-				sources, diffs, err := qualifySourcesForUpdate(ctx, newObj, oldObj, sourceRoutes, r.log, r.mapper, r.dynamicCli)
+				sources, diffs, err := qualifySourcesForUpdate(ctx, newObj, oldObj, r.routes(resource, target), r.log, r.mapper, r.dynamicCli, r.workloadResolver)
 				if err != nil {
 					r.log.WithFields(logrus.Fields{
 						"eventHandler": config.UpdateEvent,
@@ -78,18 +95,19 @@ func (r registration) handleEvent(ctx context.Context, resource string, target c
 				}
 				r.log.Debugf("handle Update event, resource: %s, sources: %+v, diffs: %+v", resource, sources, diffs)
 				if len(sources) > 0 {
-					fn(ctx, resource, sources, diffs)(newObj)
+					fn(ctx, resource, sources, diffs, oldObj)(newObj)
 				}
 			},
 		})
 	}
 }
 
-func (r registration) handleMapped(ctx context.Context, targetEvent config.EventType, routeTable map[string][]entry, fn eventHandler) {
+func (r *registration) handleMapped(ctx context.Context, targetEvent config.EventType, fn eventHandler) {
+	r.handled[targetEvent] = struct{}{}
+
 	r.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
-			var eventObj coreV1.Event
-			err := utils.TransformIntoTypedObject(obj.(*unstructured.Unstructured), &eventObj)
+			eventObj, err := utils.TransformIntoCoreEvent(obj.(*unstructured.Unstructured))
 			if err != nil {
 				r.log.Errorf("Unable to transform object type: %v, into type: %v", reflect.TypeOf(obj), reflect.TypeOf(eventObj))
 				return
@@ -112,43 +130,70 @@ func (r registration) handleMapped(ctx context.Context, targetEvent config.Event
 			}
 
 			gvrToString := utils.GVRToString(gvr)
-			if !r.includesSrcResource(gvrToString) {
+			sourceRoutes := routesForReason(r.routes(gvrToString, targetEvent), eventObj.Reason)
+			if len(sourceRoutes) == 0 {
 				return
 			}
 
-			sourceRoutes := sourceRoutes(routeTable, gvrToString, targetEvent)
-			sources, err := sourcesForObjNamespace(ctx, sourceRoutes, obj, r.log, r.mapper, r.dynamicCli)
+			sources, err := sourcesForObjNamespace(ctx, sourceRoutes, obj, r.log, r.mapper, r.dynamicCli, r.workloadResolver)
 			if err != nil {
 				r.log.Errorf("cannot calculate sources for observed mapped resource event: %q in Add event handler: %s", targetEvent, err.Error())
 				return
 			}
+			if targetEvent == config.ErrorEvent {
+				sources, err = r.withoutWarningSources(ctx, gvrToString, eventObj.Reason, obj, sources)
+				if err != nil {
+					r.log.Errorf("cannot calculate warning sources for observed mapped resource event: %q in Add event handler: %s", targetEvent, err.Error())
+					return
+				}
+			}
 			if len(sources) == 0 {
 				return
 			}
-			fn(ctx, gvrToString, sources, nil)(obj)
+			fn(ctx, gvrToString, sources, nil, nil)(obj)
 		},
 	})
 }
 
-func (r registration) canHandleEvent(target string) bool {
-	for _, e := range r.events {
-		if strings.EqualFold(target, e.String()) {
-			return true
+// withoutWarningSources removes sources which report a given Kubernetes Event as the warning one.
+// Both error and warning events are observed from the same Warning Kubernetes Events,
+// so for sources watching both of them the warning event replaces the error one.
+func (r *registration) withoutWarningSources(ctx context.Context, resource, reason string, obj interface{}, sources []string) ([]string, error) {
+	warningRoutes := routesForReason(r.routes(resource, config.WarningEvent), reason)
+	if len(warningRoutes) == 0 {
+		return sources, nil
+	}
+
+	warningSources, err := sourcesForObjNamespace(ctx, warningRoutes, obj, r.log, r.mapper, r.dynamicCli, r.workloadResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	reported := map[string]struct{}{}
+	for _, source := range warningSources {
+		reported[source] = struct{}{}
+	}
+
+	var out []string
+	for _, source := range sources {
+		if _, ok := reported[source]; ok {
+			continue
 		}
+		out = append(out, source)
 	}
-	return false
+	return out, nil
 }
 
-func (r registration) includesSrcResource(resource string) bool {
-	for _, src := range r.mappedResources {
-		if src == resource {
+func (r *registration) canHandleEvent(target string) bool {
+	for _, e := range r.events {
+		if strings.EqualFold(target, e.String()) {
 			return true
 		}
 	}
 	return false
 }
 
-func sourcesForObjNamespace(ctx context.Context, routes []route, obj interface{}, log logrus.FieldLogger, mapper meta.RESTMapper, cli dynamic.Interface) ([]string, error) {
+func sourcesForObjNamespace(ctx context.Context, routes []route, obj interface{}, log logrus.FieldLogger, mapper meta.RESTMapper, cli dynamic.Interface, workloadResolver *workload.Resolver) ([]string, error) {
 	var out []string
 
 	objectMeta, err := utils.GetObjectMetaData(ctx, cli, mapper, obj)
@@ -160,10 +205,32 @@ func sourcesForObjNamespace(ctx context.Context, routes []route, obj interface{}
 	targetNs := objectMeta.Namespace
 	log.Debugf("handling events for target Namespace: %s in routes: %+v", targetNs, routes)
 
+	var (
+		owner         workload.Owner
+		ownerErr      error
+		ownerResolved bool
+	)
 	for _, route := range routes {
-		if route.namespaces.IsAllowed(targetNs) {
-			out = append(out, route.source)
+		if !route.namespaces.IsAllowed(targetNs) {
+			continue
 		}
+
+		if route.owner.IsConfigured() {
+			if !ownerResolved {
+				owner, ownerErr = workloadResolver.TopLevelOwner(ctx, obj)
+				if ownerErr != nil {
+					log.Errorf("while resolving top-level owner: %s. Skipping sources filtered by owner...", ownerErr.Error())
+				}
+				ownerResolved = true
+			}
+
+			// the owner is unknown, so only routes without the owner filter are matched
+			if ownerErr != nil || !route.owner.Matches(owner.Kind, owner.Name) {
+				continue
+			}
+		}
+
+		out = append(out, route.source)
 	}
 
 	return out, nil
@@ -176,10 +243,11 @@ func qualifySourcesForUpdate(
 	log logrus.FieldLogger,
 	mapper meta.RESTMapper,
 	cli dynamic.Interface,
+	workloadResolver *workload.Resolver,
 ) ([]string, []string, error) {
 	var sources, diffs []string
 
-	candidates, err := sourcesForObjNamespace(ctx, routes, newObj, log, mapper, cli)
+	candidates, err := sourcesForObjNamespace(ctx, routes, newObj, log, mapper, cli, workloadResolver)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -215,7 +283,7 @@ func qualifySourcesForUpdate(
 			}
 			log.Debugf("About to qualify source: %s for update, diff: %s, updateSetting: %+v", source, diff, r.updateSetting)
 
-			if len(diff) > 0 && r.updateSetting.IncludeDiff {
+			if len(diff) > 0 && r.updateSetting.ShouldIncludeDiff() {
 				sources = append(sources, source)
 				diffs = append(diffs, diff)
 				log.Debugf("Qualified for update: source: %s for update, diff: %s, updateSetting: %+v", source, diff, r.updateSetting)
@@ -0,0 +1,210 @@
+package sources
+
+import (
+	"context"
+	"testing"
+
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/kubeshop/botkube/pkg/config"
+)
+
+func TestRegistration_HandleMappedFiltersReasons(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	cfg := &config.Config{
+		Sources: map[string]config.Sources{
+			"k8s-err-events": {
+				Kubernetes: config.KubernetesSource{
+					Events:     []config.EventType{config.ErrorEvent},
+					Namespaces: config.Namespaces{Include: []string{".*"}},
+					Reasons:    config.EventReasons{Exclude: []string{"FailedMount|FailedAttachVolume"}},
+					Resources: []config.Resource{
+						{Name: "v1/pods"},
+					},
+				},
+			},
+			"k8s-mount-events": {
+				Kubernetes: config.KubernetesSource{
+					Events:     []config.EventType{config.ErrorEvent},
+					Namespaces: config.Namespaces{Include: []string{".*"}},
+					Reasons:    config.EventReasons{Exclude: []string{".*"}},
+					Resources: []config.Resource{
+						{
+							Name:    "v1/pods",
+							Reasons: config.EventReasons{Include: []string{"Failed.*"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pod := &coreV1.Pod{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "api", Namespace: "team-a"},
+	}
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+
+	router := NewRouter(mapper, fake.NewSimpleDynamicClient(scheme.Scheme, pod), logger).
+		AddAnyBindings(config.BotBindings{Sources: []string{"k8s-err-events", "k8s-mount-events"}}).
+		BuildTable(cfg)
+
+	informer := &fakeInformer{}
+	err := router.MapWithEventsInformer(config.ErrorEvent, config.WarningEvent, func(string) (cache.SharedIndexInformer, error) {
+		return informer, nil
+	})
+	require.NoError(t, err)
+
+	handled := map[string][]string{}
+	router.HandleMappedEvent(context.Background(), config.ErrorEvent, func(_ context.Context, _ string, sources []string, _ []string, _ interface{}) func(obj interface{}) {
+		return func(obj interface{}) {
+			handled[obj.(*unstructured.Unstructured).GetName()] = sources
+		}
+	})
+	require.Len(t, informer.handlers, 1)
+
+	// when
+	for _, reason := range []string{"BackOff", "FailedMount", "Pulled"} {
+		informer.handlers[0].OnAdd(fixWarningEvent(t, reason))
+	}
+
+	// then
+	assert.Equal(t, map[string][]string{
+		"api.BackOff":     {"k8s-err-events"},
+		"api.FailedMount": {"k8s-mount-events"},
+		"api.Pulled":      {"k8s-err-events"},
+	}, handled)
+}
+
+func TestRegistration_HandleMappedReportsWarningInsteadOfError(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	cfg := &config.Config{
+		Sources: map[string]config.Sources{
+			"k8s-err-events": {
+				Kubernetes: config.KubernetesSource{
+					Events:     []config.EventType{config.ErrorEvent},
+					Namespaces: config.Namespaces{Include: []string{".*"}},
+					Resources:  []config.Resource{{Name: "v1/pods"}},
+				},
+			},
+			"k8s-all-events": {
+				Kubernetes: config.KubernetesSource{
+					Events:     []config.EventType{config.ErrorEvent, config.WarningEvent},
+					Namespaces: config.Namespaces{Include: []string{".*"}},
+					Resources:  []config.Resource{{Name: "v1/pods"}},
+				},
+			},
+		},
+	}
+
+	pod := &coreV1.Pod{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "api", Namespace: "team-a"},
+	}
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+
+	router := NewRouter(mapper, fake.NewSimpleDynamicClient(scheme.Scheme, pod), logger).
+		AddAnyBindings(config.BotBindings{Sources: []string{"k8s-err-events", "k8s-all-events"}}).
+		BuildTable(cfg)
+
+	informer := &fakeInformer{}
+	handled := map[config.EventType][]string{}
+	for _, eventType := range []config.EventType{config.ErrorEvent, config.WarningEvent} {
+		eventType := eventType
+		err := router.MapWithEventsInformer(eventType, config.WarningEvent, func(string) (cache.SharedIndexInformer, error) {
+			return informer, nil
+		})
+		require.NoError(t, err)
+
+		router.HandleMappedEvent(context.Background(), eventType, func(_ context.Context, _ string, sources []string, _ []string, _ interface{}) func(obj interface{}) {
+			return func(obj interface{}) {
+				handled[eventType] = append(handled[eventType], sources...)
+			}
+		})
+	}
+	require.Len(t, informer.handlers, 2)
+
+	// when
+	for _, handler := range informer.handlers {
+		handler.OnAdd(fixWarningEvent(t, "BackOff"))
+	}
+
+	// then
+	assert.Equal(t, map[config.EventType][]string{
+		config.ErrorEvent:   {"k8s-err-events"},
+		config.WarningEvent: {"k8s-all-events"},
+	}, handled)
+}
+
+func fixWarningEvent(t *testing.T, reason string) *unstructured.Unstructured {
+	t.Helper()
+
+	event := &coreV1.Event{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "api." + reason, Namespace: "team-a"},
+		InvolvedObject: coreV1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Name:       "api",
+			Namespace:  "team-a",
+		},
+		Reason: reason,
+		Type:   "Warning",
+	}
+	out, err := runtime.DefaultUnstructuredConverter.ToUnstructured(event)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: out}
+}
+
+// fakeInformer captures the registered event handlers.
+type fakeInformer struct {
+	cache.SharedIndexInformer
+
+	handlers []cache.ResourceEventHandler
+}
+
+func (f *fakeInformer) AddEventHandler(handler cache.ResourceEventHandler) {
+	f.handlers = append(f.handlers, handler)
+}
+
+func TestQualifySourcesForUpdate_FullObjectDiffImpliesIncludeDiff(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	allNs := (&config.Namespaces{Include: []string{".*"}}).Matcher()
+	routes := []route{
+		{source: "full-diff", namespaces: allNs, updateSetting: config.UpdateSetting{FullObjectDiff: config.FullObjectDiff{Enabled: true}}},
+		{source: "fields-without-diff", namespaces: allNs, updateSetting: config.UpdateSetting{Fields: []string{"spec.replicas"}}},
+	}
+	newObj := func(replicas int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "api", "namespace": "default"},
+			"spec":       map[string]interface{}{"replicas": replicas},
+		}}
+	}
+
+	// when
+	sources, diffs, err := qualifySourcesForUpdate(context.Background(), newObj(3), newObj(1), routes, logger, nil, nil, nil)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"full-diff"}, sources)
+	require.Len(t, diffs, 1)
+	assert.Contains(t, diffs[0], "spec.replicas")
+}
@@ -2,6 +2,8 @@ package sources
 
 import (
 	"context"
+	"sort"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -10,22 +12,26 @@ import (
 
 	"github.com/kubeshop/botkube/pkg/config"
 	"github.com/kubeshop/botkube/pkg/recommendation"
+	"github.com/kubeshop/botkube/pkg/workload"
 )
 
-const eventsResource = "v1/events"
-
 type mergedEvents map[string]map[config.EventType]struct{}
 type registrationHandler func(resource string) (cache.SharedIndexInformer, error)
-type eventHandler func(ctx context.Context, resource string, sources []string, updateDiffs []string) func(obj interface{})
+type eventHandler func(ctx context.Context, resource string, sources []string, updateDiffs []string, oldObj interface{}) func(obj interface{})
 
+// route describes a source which is notified about a given resource event.
+// The namespaces and reasons are compiled when the routing table is built, as they are matched for every observed object.
 type route struct {
 	source        string
-	namespaces    config.Namespaces
+	namespaces    config.NamespacesMatcher
 	updateSetting config.UpdateSetting
+	owner         config.ResourceOwner
+	conditions    []config.ResourceCondition
+	reasons       config.EventReasonsMatcher
 }
 
 func (r route) hasActionableUpdateSetting() bool {
-	return len(r.updateSetting.Fields) > 0
+	return len(r.updateSetting.Fields) > 0 || r.updateSetting.FullObjectDiff.Enabled
 }
 
 type entry struct {
@@ -36,24 +42,59 @@ type entry struct {
 // Router routes handled event types from registered
 // informers to configured sources
 type Router struct {
-	log           logrus.FieldLogger
-	mapper        meta.RESTMapper
-	dynamicCli    dynamic.Interface
-	table         map[string][]entry
-	bindings      map[string]struct{}
-	registrations map[string]registration
+	log        logrus.FieldLogger
+	mapper     meta.RESTMapper
+	dynamicCli dynamic.Interface
+	// workloadResolver resolves the top-level owners for routes with the owner filter.
+	workloadResolver *workload.Resolver
+	bindings         map[string]struct{}
+	registrations    map[string]*registration
+	// eventsResource is watched to report events, which can be observed only via Kubernetes Events.
+	eventsResource string
+
+	// mappedRegistrations are identified by the source event type.
+	mappedRegistrations map[config.EventType]*registration
+
+	tableMutex sync.RWMutex
+	table      map[string][]entry
 }
 
 // NewRouter creates a new router to use for routing event types to registered informers.
 func NewRouter(mapper meta.RESTMapper, dynamicCli dynamic.Interface, log logrus.FieldLogger) *Router {
 	return &Router{
-		log:           log,
-		mapper:        mapper,
-		dynamicCli:    dynamicCli,
-		table:         make(map[string][]entry),
-		bindings:      make(map[string]struct{}),
-		registrations: make(map[string]registration),
+		log:                 log,
+		mapper:              mapper,
+		dynamicCli:          dynamicCli,
+		workloadResolver:    workload.NewResolver(dynamicCli, mapper),
+		table:               make(map[string][]entry),
+		eventsResource:      config.CoreEventsResource,
+		bindings:            make(map[string]struct{}),
+		registrations:       make(map[string]*registration),
+		mappedRegistrations: make(map[config.EventType]*registration),
+	}
+}
+
+// WithEventsResource sets the Kubernetes Events resource used to observe mapped events.
+// Empty resource is ignored, and the core v1/events resource is used.
+func (r *Router) WithEventsResource(resource string) *Router {
+	if resource != "" {
+		r.eventsResource = resource
 	}
+	return r
+}
+
+// WithWorkloadResolver sets the resolver used to match routes with the owner filter.
+// It allows sharing the resolver cache with other components which resolve the top-level owners of the same objects.
+func (r *Router) WithWorkloadResolver(resolver *workload.Resolver) *Router {
+	if resolver != nil {
+		r.workloadResolver = resolver
+	}
+	return r
+}
+
+// EventsResource returns the Kubernetes Events resource used to observe mapped events.
+func (r *Router) EventsResource() string {
+	return r.eventsResource
 }
 
 // AddCommunicationsBindings adds source binding from a given communications
@@ -62,6 +103,7 @@ func (r *Router) AddCommunicationsBindings(c config.Communications) {
 	r.AddAnyBindingsByName(c.SocketSlack.Channels)
 	r.AddAnyBindingsByName(c.Mattermost.Channels)
 	r.AddAnyBindings(c.Teams.Bindings)
+	r.AddAnyBindingsByName(c.Teams.Channels)
 	r.AddAnyBindingsByID(c.Discord.Channels)
 	for _, index := range c.Elasticsearch.Indices {
 		r.AddAnySinkBindings(index.Bindings)
@@ -119,35 +161,50 @@ func (r *Router) GetBoundSources(sources map[string]config.Sources) map[string]c
 
 // BuildTable builds the routers routing table marking it ready
 // to register, map and handle informer events.
+// The previous routing table is replaced, so it can be used to apply a new configuration.
 func (r *Router) BuildTable(cfg *config.Config) *Router {
 	sources := r.GetBoundSources(cfg.Sources)
 	mergedEvents := mergeResourceEvents(sources)
 
+	table := make(map[string][]entry)
 	for resource, resourceEvents := range mergedEvents {
 		eventRoutes := r.mergeEventRoutes(resource, sources)
 		for evt := range resourceEvents {
-			r.table[resource] = append(r.table[resource], entry{event: evt, routes: eventRoutes[evt]})
+			table[resource] = append(table[resource], entry{event: evt, routes: eventRoutes[evt]})
 		}
 	}
-	r.log.Debugf("sources routing table: %+v", r.table)
+	r.log.Debugf("sources routing table: %+v", table)
+
+	r.tableMutex.Lock()
+	defer r.tableMutex.Unlock()
+	r.table = table
 	return r
 }
 
+// Rebuild resets the source bindings and rebuilds the routing table for a given configuration.
+// The already registered informers use the new routing table immediately.
+func (r *Router) Rebuild(cfg *config.Config) *Router {
+	r.bindings = make(map[string]struct{})
+	for _, commGroupCfg := range cfg.Communications {
+		r.AddCommunicationsBindings(commGroupCfg)
+	}
+	return r.BuildTable(cfg)
+}
+
 // RegisterInformers register informers for all the resources that match the target events.
+// Resources which already have informer registered are skipped.
 func (r *Router) RegisterInformers(targetEvents []config.EventType, handler registrationHandler) error {
 	resources := r.resourcesForEvents(targetEvents)
 	for _, resource := range resources {
+		if _, exists := r.registrations[resource]; exists {
+			continue
+		}
+
 		informer, err := handler(resource)
 		if err != nil {
 			return err
 		}
-		r.registrations[resource] = registration{
-			informer:   informer,
-			events:     r.resourceEvents(resource),
-			log:        r.log,
-			mapper:     r.mapper,
-			dynamicCli: r.dynamicCli,
-		}
+		r.registrations[resource] = r.newRegistration(informer)
 	}
 	return nil
 }
@@ -165,45 +222,121 @@ func (r *Router) MapWithEventsInformer(srcEvent config.EventType, dstEvent confi
 		return nil
 	}
 
-	informer, err := handler(eventsResource)
+	if _, exists := r.mappedRegistrations[srcEvent]; exists {
+		return nil
+	}
+
+	informer, err := handler(r.eventsResource)
 	if err != nil {
 		return err
 	}
-	r.registrations[eventsResource] = registration{
-		informer:        informer,
-		events:          []config.EventType{dstEvent},
-		mappedResources: srcResources,
-		mappedEvent:     srcEvent,
-		log:             r.log,
-		mapper:          r.mapper,
-		dynamicCli:      r.dynamicCli,
-	}
+	reg := r.newRegistration(informer)
+	reg.events = []config.EventType{dstEvent}
+	reg.mappedEvent = srcEvent
+	r.mappedRegistrations[srcEvent] = reg
 	return nil
 }
 
 // HandleEvent allows router clients to create handlers that are
 // triggered for a target event.
+// The handler is registered only once per informer.
 func (r *Router) HandleEvent(ctx context.Context, target config.EventType, handlerFn eventHandler) {
 	for resource, informer := range r.registrations {
-		if !informer.canHandleEvent(target.String()) {
+		if !r.hasEvent(resource, target) || informer.isHandled(target) {
 			continue
 		}
-		sourceRoutes := r.getSourceRoutes(resource, target)
-		informer.handleEvent(ctx, resource, target, sourceRoutes, handlerFn)
+		informer.handleEvent(ctx, resource, target, handlerFn)
 	}
 }
 
 // HandleMappedEvent allows router clients to create handlers that are
 // triggered for a target mapped event.
 func (r *Router) HandleMappedEvent(ctx context.Context, targetEvent config.EventType, handlerFn eventHandler) {
-	if informer, ok := r.mappedInformer(targetEvent); ok {
-		informer.handleMapped(ctx, targetEvent, r.table, handlerFn)
+	informer, ok := r.mappedRegistrations[targetEvent]
+	if !ok || informer.isHandled(targetEvent) {
+		return
+	}
+	informer.handleMapped(ctx, targetEvent, handlerFn)
+}
+
+// UnregisterUnused removes the informer registrations which are not used by the routing table anymore.
+// As the v1/events informer is shared between its own registration and the mapped ones,
+// they are removed only if none of them is used. Otherwise, the event handlers would be added twice
+// to the same informer once the registration is recreated.
+func (r *Router) UnregisterUnused() {
+	used := map[string]struct{}{}
+	for _, resource := range r.resourcesForEvents([]config.EventType{config.CreateEvent, config.UpdateEvent, config.DeleteEvent}) {
+		used[resource] = struct{}{}
+	}
+	for _, resource := range r.resourcesWithConditions() {
+		used[resource] = struct{}{}
+	}
+	for srcEvent := range r.mappedRegistrations {
+		if len(r.resourcesForEvents([]config.EventType{srcEvent})) > 0 {
+			used[r.eventsResource] = struct{}{}
+		}
+	}
+
+	for resource := range r.registrations {
+		if _, ok := used[resource]; !ok {
+			delete(r.registrations, resource)
+		}
+	}
+
+	if _, ok := used[r.eventsResource]; !ok {
+		r.mappedRegistrations = make(map[config.EventType]*registration)
+	}
+}
+
+// RegisteredResources returns the sorted list of resources which have informers registered.
+func (r *Router) RegisteredResources() []string {
+	set := map[string]struct{}{}
+	for resource := range r.registrations {
+		set[resource] = struct{}{}
+	}
+	if len(r.mappedRegistrations) > 0 {
+		set[r.eventsResource] = struct{}{}
+	}
+
+	out := make([]string, 0, len(set))
+	for resource := range set {
+		out = append(out, resource)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (r *Router) newRegistration(informer cache.SharedIndexInformer) *registration {
+	return &registration{
+		informer:         informer,
+		log:              r.log,
+		mapper:           r.mapper,
+		dynamicCli:       r.dynamicCli,
+		workloadResolver: r.workloadResolver,
+		routes:           r.getSourceRoutes,
+		handled:          map[config.EventType]struct{}{},
 	}
 }
 
 // GetSourceRoutes returns all routes for a resource and target event
 func (r *Router) getSourceRoutes(resource string, targetEvent config.EventType) []route {
-	return sourceRoutes(r.table, resource, targetEvent)
+	return sourceRoutes(r.getTable(), resource, targetEvent)
+}
+
+// getTable returns the current routing table. The table is never modified once built, so it can be read without locking.
+func (r *Router) getTable() map[string][]entry {
+	r.tableMutex.RLock()
+	defer r.tableMutex.RUnlock()
+	return r.table
+}
+
+func (r *Router) hasEvent(resource string, target config.EventType) bool {
+	for _, routedEvent := range r.getTable()[resource] {
+		if routedEvent.event == target {
+			return true
+		}
+	}
+	return false
 }
 
 func mergeResourceEvents(sources map[string]config.Sources) mergedEvents {
@@ -219,11 +352,13 @@ func mergeResourceEvents(sources map[string]config.Sources) mergedEvents {
 		}
 
 		resForRecomms := recommendation.ResourceEventsForConfig(srcGroupCfg.Kubernetes.Recommendations)
-		for resourceName, eventType := range resForRecomms {
+		for resourceName, eventTypes := range resForRecomms {
 			if _, ok := out[resourceName]; !ok {
 				out[resourceName] = make(map[config.EventType]struct{})
 			}
-			out[resourceName][eventType] = struct{}{}
+			for _, eventType := range eventTypes {
+				out[resourceName][eventType] = struct{}{}
+			}
 		}
 	}
 	return out
@@ -239,11 +374,18 @@ func (r *Router) mergeEventRoutes(resource string, sources map[string]config.Sou
 				}
 
 				namespaces := sourceOrResourceNamespaces(srcGroupCfg.Kubernetes.Namespaces, r.Namespaces)
-				route := route{source: srcGroupName, namespaces: namespaces}
+				route := route{source: srcGroupName, namespaces: namespaces.Matcher(), owner: r.Owner}
+				if e == config.ErrorEvent {
+					route.conditions = r.Conditions
+				}
+				if isMappedEvent(e) {
+					route.reasons = sourceOrResourceReasons(srcGroupCfg.Kubernetes.Reasons, r.Reasons).Matcher()
+				}
 				if e == config.UpdateEvent {
 					route.updateSetting = config.UpdateSetting{
-						Fields:      r.UpdateSetting.Fields,
-						IncludeDiff: r.UpdateSetting.IncludeDiff,
+						Fields:         r.UpdateSetting.Fields,
+						IncludeDiff:    r.UpdateSetting.IncludeDiff,
+						FullObjectDiff: r.UpdateSetting.FullObjectDiff,
 					}
 				}
 				out[e] = append(out[e], route)
@@ -258,62 +400,94 @@ func (r *Router) mergeEventRoutes(resource string, sources map[string]config.Sou
 	return out
 }
 
-func (r *Router) setEventRouteForRecommendationsIfShould(routeMap *map[config.EventType][]route, resForRecomms map[string]config.EventType, srcGroupName, resourceName string) {
+func (r *Router) setEventRouteForRecommendationsIfShould(routeMap *map[config.EventType][]route, resForRecomms map[string]config.KubernetesResourceEvents, srcGroupName, resourceName string) {
 	if routeMap == nil {
 		r.log.Debug("Skipping setting event route for recommendations as the routeMap is nil")
 		return
 	}
 
-	eventType, found := resForRecomms[resourceName]
+	eventTypes, found := resForRecomms[resourceName]
 	if !found {
 		return
 	}
 
+	for _, eventType := range eventTypes {
+		setEventRouteForRecommendations(*routeMap, eventType, srcGroupName)
+	}
+}
+
+func setEventRouteForRecommendations(routeMap map[config.EventType][]route, eventType config.EventType, srcGroupName string) {
+	allNamespaces := config.Namespaces{
+		Include: []string{config.AllNamespaceIndicator},
+	}
 	recommRoute := route{
-		source: srcGroupName,
-		namespaces: config.Namespaces{
-			Include: []string{config.AllNamespaceIndicator},
-		},
+		source:     srcGroupName,
+		namespaces: allNamespaces.Matcher(),
+	}
+	if eventType == config.UpdateEvent {
+		// Qualify only updates with significant changes, so the recommendations are not repeated on every status update.
+		recommRoute.updateSetting = config.UpdateSetting{
+			IncludeDiff:    true,
+			FullObjectDiff: config.FullObjectDiff{Enabled: true},
+		}
 	}
 
 	// Override route and get all these events for all namespaces.
 	// The events without recommendations will be filtered out when sending the event.
-	for i, r := range (*routeMap)[eventType] {
+	for i, r := range routeMap[eventType] {
 		if r.source != srcGroupName {
 			continue
 		}
 
 		recommRoute.namespaces = r.namespaces
-		(*routeMap)[eventType][i] = recommRoute
+		recommRoute.updateSetting = r.updateSetting
+		recommRoute.owner = r.owner
+		recommRoute.conditions = r.conditions
+		recommRoute.reasons = r.reasons
+		routeMap[eventType][i] = recommRoute
 		return
 	}
 
 	// not found, append new route
-	(*routeMap)[eventType] = append((*routeMap)[eventType], recommRoute)
+	routeMap[eventType] = append(routeMap[eventType], recommRoute)
 }
 
-func sourceRoutes(routeTable map[string][]entry, targetResource string, targetEvent config.EventType) []route {
+// isMappedEvent returns true if a given event type is reported based on the Kubernetes Events.
+func isMappedEvent(eventType config.EventType) bool {
+	switch eventType {
+	case config.ErrorEvent, config.WarningEvent, config.NormalEvent:
+		return true
+	}
+	return false
+}
+
+// routesForReason returns routes which allow a given Kubernetes Event reason.
+func routesForReason(routes []route, reason string) []route {
 	var out []route
-	for _, routedEvent := range routeTable[targetResource] {
-		if routedEvent.event == targetEvent {
-			out = append(out, routedEvent.routes...)
+	for _, route := range routes {
+		if route.reasons.IsAllowed(reason) {
+			out = append(out, route)
 		}
 	}
 	return out
 }
 
-func (r *Router) resourceEvents(resource string) []config.EventType {
-	var out []config.EventType
-	for _, routedEvent := range r.table[resource] {
-		out = append(out, routedEvent.event)
+func sourceRoutes(routeTable map[string][]entry, targetResource string, targetEvent config.EventType) []route {
+	var out []route
+	for _, routedEvent := range routeTable[targetResource] {
+		if routedEvent.event == targetEvent {
+			out = append(out, routedEvent.routes...)
+		}
 	}
 	return out
 }
 
 func (r *Router) resourcesForEvents(targets []config.EventType) []string {
+	table := r.getTable()
+
 	var out []string
 	for _, target := range targets {
-		for resource, routedEvents := range r.table {
+		for resource, routedEvents := range table {
 			for _, routedEvent := range routedEvents {
 				if routedEvent.event == target {
 					out = append(out, resource)
@@ -325,15 +499,6 @@ func (r *Router) resourcesForEvents(targets []config.EventType) []string {
 	return out
 }
 
-func (r *Router) mappedInformer(event config.EventType) (registration, bool) {
-	for _, informer := range r.registrations {
-		if informer.mappedEvent == event {
-			return informer, true
-		}
-	}
-	return registration{}, false
-}
-
 func flattenEvents(globalEvents []config.EventType, resourceEvents config.KubernetesResourceEvents) []config.EventType {
 	checkEvents := globalEvents
 	if len(resourceEvents) > 0 {
@@ -359,3 +524,12 @@ func sourceOrResourceNamespaces(sourceNs, resourceNs config.Namespaces) config.N
 	}
 	return sourceNs
 }
+
+// sourceOrResourceReasons returns the kubernetes source event reasons
+// unless the resource reasons are configured.
+func sourceOrResourceReasons(sourceReasons, resourceReasons config.EventReasons) config.EventReasons {
+	if resourceReasons.IsConfigured() {
+		return resourceReasons
+	}
+	return sourceReasons
+}
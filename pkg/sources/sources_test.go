@@ -1,13 +1,26 @@
 package sources
 
 import (
+	"context"
 	"testing"
 
 	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsV1 "k8s.io/api/apps/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/kubeshop/botkube/pkg/config"
+	"github.com/kubeshop/botkube/pkg/ptr"
+	"github.com/kubeshop/botkube/pkg/workload"
 )
 
 func TestRouter_GetBoundSources_UsesAddedBindings(t *testing.T) {
@@ -305,16 +318,16 @@ func TestRouter_BuildTable_CreatesRoutesWithNamespacesPresetFromKubernetesSource
 				getSourceRoutes("apps/v1/deployments", config.CreateEvent)
 
 			assert.Len(t, routes, 1)
-			assert.Equal(t, tc.Expected, routes[0].namespaces)
+			assert.Equal(t, tc.Expected.Matcher(), routes[0].namespaces)
 		})
 	}
 }
 
 func TestSetEventRouteForRecommendationsIfShould(t *testing.T) {
 	// given
-	resForRecomms := map[string]config.EventType{
-		"v1/pods":                      config.CreateEvent,
-		"networking.k8s.io/v1/ingress": config.CreateEvent,
+	resForRecomms := map[string]config.KubernetesResourceEvents{
+		"v1/pods":                      {config.CreateEvent},
+		"networking.k8s.io/v1/ingress": {config.CreateEvent},
 	}
 	resourceName := "v1/pods"
 	srcGroupName := "foo"
@@ -331,7 +344,7 @@ func TestSetEventRouteForRecommendationsIfShould(t *testing.T) {
 				config.UpdateEvent: {{source: "foo"}, {source: "bar"}},
 			},
 			Expected: map[config.EventType][]route{
-				config.CreateEvent: {{source: "foo", namespaces: config.Namespaces{Include: []string{config.AllNamespaceIndicator}}}},
+				config.CreateEvent: {{source: "foo", namespaces: (&config.Namespaces{Include: []string{config.AllNamespaceIndicator}}).Matcher()}},
 				config.UpdateEvent: {{source: "foo"}, {source: "bar"}},
 			},
 		},
@@ -344,10 +357,10 @@ func TestSetEventRouteForRecommendationsIfShould(t *testing.T) {
 					},
 					{
 						source: "foo",
-						namespaces: config.Namespaces{
+						namespaces: (&config.Namespaces{
 							Include: []string{"foo", "bar"},
 							Exclude: []string{"default"},
-						},
+						}).Matcher(),
 					},
 					{
 						source: "baz",
@@ -362,10 +375,10 @@ func TestSetEventRouteForRecommendationsIfShould(t *testing.T) {
 					},
 					{
 						source: "foo",
-						namespaces: config.Namespaces{
+						namespaces: (&config.Namespaces{
 							Include: []string{"foo", "bar"},
 							Exclude: []string{"default"},
-						},
+						}).Matcher(),
 					},
 					{
 						source: "baz",
@@ -388,3 +401,251 @@ func TestSetEventRouteForRecommendationsIfShould(t *testing.T) {
 		})
 	}
 }
+
+func TestSetEventRouteForRecommendationsIfShould_Update(t *testing.T) {
+	// given
+	resForRecomms := map[string]config.KubernetesResourceEvents{
+		"v1/pods": {config.CreateEvent, config.UpdateEvent},
+	}
+	userUpdateSetting := config.UpdateSetting{Fields: []string{"spec.nodeName"}, IncludeDiff: true}
+	input := map[config.EventType][]route{
+		config.UpdateEvent: {{source: "bar", updateSetting: userUpdateSetting}},
+	}
+	allNamespaces := (&config.Namespaces{Include: []string{config.AllNamespaceIndicator}}).Matcher()
+	expected := map[config.EventType][]route{
+		config.CreateEvent: {{source: "foo", namespaces: allNamespaces}},
+		config.UpdateEvent: {
+			{source: "bar", updateSetting: userUpdateSetting},
+			{
+				source:     "foo",
+				namespaces: allNamespaces,
+				updateSetting: config.UpdateSetting{
+					IncludeDiff:    true,
+					FullObjectDiff: config.FullObjectDiff{Enabled: true},
+				},
+			},
+		},
+	}
+	r := &Router{}
+
+	// when
+	r.setEventRouteForRecommendationsIfShould(&input, resForRecomms, "foo", "v1/pods")
+
+	// then
+	assert.Equal(t, expected, input)
+
+	// when
+	r.setEventRouteForRecommendationsIfShould(&input, resForRecomms, "bar", "v1/pods")
+
+	// then
+	assert.Equal(t, userUpdateSetting, input[config.UpdateEvent][0].updateSetting)
+}
+
+func TestSourcesForObjNamespace_MatchesOwner(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+
+	deploy := &appsV1.Deployment{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metaV1.ObjectMeta{Name: "api", Namespace: "team-a", UID: "deploy-uid"},
+	}
+	rs := &appsV1.ReplicaSet{
+		TypeMeta: metaV1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:            "api-7d9f8",
+			Namespace:       "team-a",
+			OwnerReferences: []metaV1.OwnerReference{*metaV1.NewControllerRef(deploy, deploy.GroupVersionKind())},
+		},
+	}
+	pod := &coreV1.Pod{
+		TypeMeta: metaV1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:            "api-7d9f8-xk2lp",
+			Namespace:       "team-a",
+			OwnerReferences: []metaV1.OwnerReference{*metaV1.NewControllerRef(rs, rs.GroupVersionKind())},
+		},
+	}
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, deploy, rs)
+
+	allNs := (&config.Namespaces{Include: []string{".*"}}).Matcher()
+	routes := []route{
+		{source: "all-pods", namespaces: allNs},
+		{source: "api-deploy", namespaces: allNs, owner: config.ResourceOwner{Kind: "Deployment", Name: "api"}},
+		{source: "any-deploy", namespaces: allNs, owner: config.ResourceOwner{Kind: "deployment"}},
+		{source: "other-deploy", namespaces: allNs, owner: config.ResourceOwner{Kind: "Deployment", Name: "web"}},
+		{source: "statefulsets", namespaces: allNs, owner: config.ResourceOwner{Kind: "StatefulSet"}},
+	}
+
+	unstrPod, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+
+	// when
+	sources, err := sourcesForObjNamespace(context.Background(), routes, &unstructured.Unstructured{Object: unstrPod}, logger, mapper, dynamicCli, workload.NewResolver(dynamicCli, mapper))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"all-pods", "api-deploy", "any-deploy"}, sources)
+}
+
+func TestSourcesForObjNamespace_SkipsOwnerFilteredRoutesOnOwnerError(t *testing.T) {
+	// given
+	logger, hook := logtest.NewNullLogger()
+
+	pod := &coreV1.Pod{
+		TypeMeta: metaV1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      "api-7d9f8-xk2lp",
+			Namespace: "team-a",
+			OwnerReferences: []metaV1.OwnerReference{
+				{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "api-7d9f8", Controller: ptr.Bool(true)},
+			},
+		},
+	}
+
+	// the ReplicaSet kind is unknown, so the owner cannot be resolved
+	mapper := meta.NewDefaultRESTMapper(nil)
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme)
+
+	allNs := (&config.Namespaces{Include: []string{".*"}}).Matcher()
+	routes := []route{
+		{source: "all-pods", namespaces: allNs},
+		{source: "api-deploy", namespaces: allNs, owner: config.ResourceOwner{Kind: "Deployment", Name: "api"}},
+		{source: "team-a-pods", namespaces: (&config.Namespaces{Include: []string{"team-a"}}).Matcher()},
+	}
+
+	unstrPod, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+
+	// when
+	sources, err := sourcesForObjNamespace(context.Background(), routes, &unstructured.Unstructured{Object: unstrPod}, logger, mapper, dynamicCli, workload.NewResolver(dynamicCli, mapper))
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"all-pods", "team-a-pods"}, sources)
+	require.Len(t, hook.Entries, 1)
+	assert.Contains(t, hook.LastEntry().Message, "while resolving top-level owner")
+}
+
+func TestRouter_Rebuild_UnregistersUnusedResources(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	fixCfg := func(resources ...config.Resource) *config.Config {
+		return &config.Config{
+			Sources: map[string]config.Sources{
+				"k8s-events": {
+					Kubernetes: config.KubernetesSource{Resources: resources},
+				},
+			},
+			Communications: map[string]config.Communications{
+				"default-group": {
+					Slack: config.Slack{
+						Channels: config.IdentifiableMap[config.ChannelBindingsByName]{
+							"default": {Name: "foo", Bindings: config.BotBindings{Sources: []string{"k8s-events"}}},
+						},
+					},
+				},
+			},
+		}
+	}
+	registerFn := func(router *Router) {
+		handler := func(resource string) (cache.SharedIndexInformer, error) {
+			return cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{}), nil
+		}
+		require.NoError(t, router.RegisterInformers([]config.EventType{config.CreateEvent, config.UpdateEvent, config.DeleteEvent}, handler))
+		require.NoError(t, router.MapWithEventsInformer(config.ErrorEvent, config.WarningEvent, handler))
+	}
+
+	router := NewRouter(nil, nil, logger).Rebuild(fixCfg(
+		config.Resource{Name: "v1/pods", Events: []config.EventType{config.CreateEvent}},
+		config.Resource{Name: "apps/v1/deployments", Events: []config.EventType{config.ErrorEvent}},
+	))
+	registerFn(router)
+	require.Equal(t, []string{"v1/events", "v1/pods"}, router.RegisteredResources())
+
+	// when
+	router.Rebuild(fixCfg(
+		config.Resource{Name: "apps/v1/deployments", Events: []config.EventType{config.DeleteEvent}},
+	))
+	registerFn(router)
+	router.UnregisterUnused()
+
+	// then
+	assert.Equal(t, []string{"apps/v1/deployments"}, router.RegisteredResources())
+	assert.Empty(t, router.getSourceRoutes("v1/pods", config.CreateEvent))
+	assert.Len(t, router.getSourceRoutes("apps/v1/deployments", config.DeleteEvent), 1)
+}
+
+func TestRouter_MapWithEventsInformer_UsesEventsResource(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	cfg := &config.Config{
+		Sources: map[string]config.Sources{
+			"k8s-events": {
+				Kubernetes: config.KubernetesSource{
+					Resources: []config.Resource{{Name: "v1/pods", Events: []config.EventType{config.ErrorEvent}}},
+				},
+			},
+		},
+	}
+	router := NewRouter(nil, nil, logger).
+		WithEventsResource(config.EventsAPIEventsResource).
+		AddAnyBindings(config.BotBindings{Sources: []string{"k8s-events"}}).
+		BuildTable(cfg)
+
+	var registered []string
+	handler := func(resource string) (cache.SharedIndexInformer, error) {
+		registered = append(registered, resource)
+		return cache.NewSharedIndexInformer(&cache.ListWatch{}, &unstructured.Unstructured{}, 0, cache.Indexers{}), nil
+	}
+
+	// when
+	err := router.MapWithEventsInformer(config.ErrorEvent, config.WarningEvent, handler)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"events.k8s.io/v1/events"}, registered)
+	assert.Equal(t, []string{"events.k8s.io/v1/events"}, router.RegisteredResources())
+}
+
+func TestRouter_BuildTable_SetsReasonsForMappedEvents(t *testing.T) {
+	// given
+	logger, _ := logtest.NewNullLogger()
+	reasons := config.EventReasons{Include: []string{"ScalingReplicaSet"}}
+	cfg := &config.Config{
+		Sources: map[string]config.Sources{
+			"k8s-events": {
+				Kubernetes: config.KubernetesSource{
+					Resources: []config.Resource{
+						{
+							Name:    "apps/v1/deployments",
+							Events:  []config.EventType{config.CreateEvent, config.WarningEvent, config.NormalEvent},
+							Reasons: reasons,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// when
+	router := NewRouter(nil, nil, logger).
+		AddAnyBindings(config.BotBindings{Sources: []string{"k8s-events"}}).
+		BuildTable(cfg)
+
+	// then
+	createRoutes := router.getSourceRoutes("apps/v1/deployments", config.CreateEvent)
+	require.Len(t, createRoutes, 1)
+	assert.Empty(t, createRoutes[0].reasons)
+
+	for _, eventType := range []config.EventType{config.WarningEvent, config.NormalEvent} {
+		routes := router.getSourceRoutes("apps/v1/deployments", eventType)
+		require.Len(t, routes, 1)
+		assert.Equal(t, reasons.Matcher(), routes[0].reasons)
+		assert.Len(t, routesForReason(routes, "ScalingReplicaSet"), 1)
+		assert.Empty(t, routesForReason(routes, "Killing"))
+	}
+}
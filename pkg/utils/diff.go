@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/kubeshop/botkube/pkg/config"
@@ -30,8 +32,14 @@ func (d diffReporter) exec(x, y interface{}) (string, error) {
 	return fmt.Sprintf("%s:\n\t-: %+v\n\t+: %+v\n", d.field, vx, vy), nil
 }
 
+const noneValue = "<none>"
+
 // Diff provides differences between two objects spec
 func Diff(x, y interface{}, updateSetting config.UpdateSetting) (string, error) {
+	if updateSetting.FullObjectDiff.Enabled {
+		return ObjectDiff(x, y, updateSetting.FullObjectDiff.IgnorePathsOrDefault())
+	}
+
 	strBldr := new(strings.Builder)
 	for _, val := range updateSetting.Fields {
 		var d diffReporter
@@ -46,3 +54,132 @@ func Diff(x, y interface{}, updateSetting config.UpdateSetting) (string, error)
 
 	return strBldr.String(), nil
 }
+
+// ObjectDiff provides differences between all fields of two objects, except the ignored paths and their nested fields.
+// Changed fields are sorted by their paths and rendered in the same format as the Diff output.
+func ObjectDiff(x, y interface{}, ignorePaths []string) (string, error) {
+	vx, err := toGenericValue(x)
+	if err != nil {
+		return "", fmt.Errorf("while converting old object: %w", err)
+	}
+	vy, err := toGenericValue(y)
+	if err != nil {
+		return "", fmt.Errorf("while converting new object: %w", err)
+	}
+
+	d := objectDiffReporter{ignorePaths: ignorePaths}
+	d.compare("", vx, vy)
+
+	strBldr := new(strings.Builder)
+	for _, change := range d.changes {
+		strBldr.WriteString(fmt.Sprintf("%s:\n\t-: %s\n\t+: %s\n", change.path, change.before, change.after))
+	}
+	return strBldr.String(), nil
+}
+
+type objectChange struct {
+	path   string
+	before string
+	after  string
+}
+
+type objectDiffReporter struct {
+	ignorePaths []string
+	changes     []objectChange
+}
+
+func (d *objectDiffReporter) compare(path string, x, y interface{}) {
+	if d.isIgnored(path) {
+		return
+	}
+
+	mx, xIsMap := x.(map[string]interface{})
+	my, yIsMap := y.(map[string]interface{})
+	if xIsMap && yIsMap {
+		for _, key := range sortedUnionKeys(mx, my) {
+			d.compare(joinPath(path, key), mx[key], my[key])
+		}
+		return
+	}
+
+	sx, xIsSlice := x.([]interface{})
+	sy, yIsSlice := y.([]interface{})
+	if xIsSlice && yIsSlice {
+		for i := 0; i < len(sx) || i < len(sy); i++ {
+			var ix, iy interface{}
+			if i < len(sx) {
+				ix = sx[i]
+			}
+			if i < len(sy) {
+				iy = sy[i]
+			}
+			d.compare(fmt.Sprintf("%s[%d]", path, i), ix, iy)
+		}
+		return
+	}
+
+	before, after := formatValue(x), formatValue(y)
+	if before == after {
+		return
+	}
+	d.changes = append(d.changes, objectChange{path: path, before: before, after: after})
+}
+
+func (d *objectDiffReporter) isIgnored(path string) bool {
+	for _, ignored := range d.ignorePaths {
+		if path == ignored || strings.HasPrefix(path, ignored+".") || strings.HasPrefix(path, ignored+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", path, key)
+}
+
+func sortedUnionKeys(x, y map[string]interface{}) []string {
+	keys := make([]string, 0, len(x)+len(y))
+	for key := range x {
+		keys = append(keys, key)
+	}
+	for key := range y {
+		if _, found := x[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatValue(in interface{}) string {
+	switch v := in.(type) {
+	case nil:
+		return noneValue
+	case map[string]interface{}, []interface{}:
+		out, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%+v", v)
+		}
+		return string(out)
+	default:
+		return fmt.Sprintf("%+v", v)
+	}
+}
+
+// toGenericValue converts a given object to a generic representation built from maps, slices and scalar values.
+func toGenericValue(in interface{}) (interface{}, error) {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -165,3 +166,109 @@ func (e *ExpectedDiff) MockDiff() string {
 	}
 	return fmt.Sprintf("%+v:\n\t-: %+v\n\t+: %+v\n", e.Path, e.X, e.Y)
 }
+
+func TestObjectDiff(t *testing.T) {
+	// given
+	old := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "api",
+			"resourceVersion": "1",
+			"labels": map[string]interface{}{
+				"app": "api",
+			},
+			"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		},
+		"spec": map[string]interface{}{
+			"replicas": 1,
+			"containers": []interface{}{
+				map[string]interface{}{"name": "api", "image": "api:1.0"},
+			},
+		},
+		"status": map[string]interface{}{
+			"readyReplicas": 1,
+		},
+	}
+	new := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":            "api",
+			"resourceVersion": "2",
+			"labels": map[string]interface{}{
+				"app":  "api",
+				"tier": "backend",
+			},
+			"managedFields": []interface{}{map[string]interface{}{"manager": "helm"}},
+		},
+		"spec": map[string]interface{}{
+			"replicas": 2,
+			"containers": []interface{}{
+				map[string]interface{}{"name": "api", "image": "api:1.1"},
+				map[string]interface{}{"name": "sidecar", "image": "proxy:1.0"},
+			},
+		},
+		"status": map[string]interface{}{
+			"readyReplicas": 2,
+		},
+	}
+
+	tests := map[string]struct {
+		ignorePaths []string
+		expected    string
+	}{
+		"Default ignore paths": {
+			ignorePaths: config.DefaultFullObjectDiffIgnorePaths,
+			expected: heredoc.Doc(`
+				metadata.labels.tier:
+					-: <none>
+					+: backend
+				spec.containers[0].image:
+					-: api:1.0
+					+: api:1.1
+				spec.containers[1]:
+					-: <none>
+					+: {"image":"proxy:1.0","name":"sidecar"}
+				spec.replicas:
+					-: 1
+					+: 2
+			`),
+		},
+		"Custom ignore paths": {
+			ignorePaths: []string{"metadata", "spec.containers"},
+			expected: heredoc.Doc(`
+				spec.replicas:
+					-: 1
+					+: 2
+				status.readyReplicas:
+					-: 1
+					+: 2
+			`),
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			// when
+			actual, err := ObjectDiff(old, new, test.ignorePaths)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestDiff_FullObjectDiff(t *testing.T) {
+	// given
+	old := Object{Spec: Spec{Port: 80}, Status: Status{Replicas: 1}}
+	new := Object{Spec: Spec{Port: 8080}, Status: Status{Replicas: 2}}
+	update := config.UpdateSetting{
+		Fields:         []string{"status.replicas"},
+		FullObjectDiff: config.FullObjectDiff{Enabled: true},
+	}
+
+	// when
+	actual, err := Diff(old, new, update)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "spec.port:\n\t-: 80\n\t+: 8080\n", actual)
+}
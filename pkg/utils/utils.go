@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	coreV1 "k8s.io/api/core/v1"
+	eventsV1 "k8s.io/api/events/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,7 +17,11 @@ import (
 	"k8s.io/client-go/dynamic"
 )
 
-const hyperlinkRegex = `(?m)<http:\/\/[a-z.0-9\/\-_=]*\|([a-z.0-9\/\-_=]*)>`
+const (
+	hyperlinkRegex = `(?m)<http:\/\/[a-z.0-9\/\-_=]*\|([a-z.0-9\/\-_=]*)>`
+
+	eventsAPIVersion = "events.k8s.io/v1"
+)
 
 // GetObjectMetaData returns metadata of the given object
 func GetObjectMetaData(ctx context.Context, dynamicCli dynamic.Interface, mapper meta.RESTMapper, obj interface{}) (metaV1.ObjectMeta, error) {
@@ -41,8 +46,7 @@ func GetObjectMetaData(ctx context.Context, dynamicCli dynamic.Interface, mapper
 		ManagedFields:              unstructuredObject.GetManagedFields(),
 	}
 	if GetObjectTypeMetaData(obj).Kind == "Event" {
-		var eventObj coreV1.Event
-		err := TransformIntoTypedObject(obj.(*unstructured.Unstructured), &eventObj)
+		eventObj, err := TransformIntoCoreEvent(obj.(*unstructured.Unstructured))
 		if err != nil {
 			return metaV1.ObjectMeta{}, fmt.Errorf("while transforming object type: %T into type %T: %w", obj, eventObj, err)
 		}
@@ -138,6 +142,48 @@ func TransformIntoTypedObject(obj *unstructured.Unstructured, typedObject interf
 	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), typedObject)
 }
 
+// TransformIntoCoreEvent creates a core Event from a given Event object.
+// Both core v1 and events.k8s.io/v1 Events are supported. The latter are converted field by field,
+// as the events.k8s.io/v1 API renamed some of them, e.g. `regarding` is the involved object, and `note` is the message.
+func TransformIntoCoreEvent(obj *unstructured.Unstructured) (coreV1.Event, error) {
+	if obj.GetAPIVersion() != eventsAPIVersion {
+		var eventObj coreV1.Event
+		err := TransformIntoTypedObject(obj, &eventObj)
+		return eventObj, err
+	}
+
+	var in eventsV1.Event
+	if err := TransformIntoTypedObject(obj, &in); err != nil {
+		return coreV1.Event{}, err
+	}
+
+	out := coreV1.Event{
+		TypeMeta:            in.TypeMeta,
+		ObjectMeta:          in.ObjectMeta,
+		InvolvedObject:      in.Regarding,
+		Reason:              in.Reason,
+		Message:             in.Note,
+		Source:              in.DeprecatedSource,
+		FirstTimestamp:      in.DeprecatedFirstTimestamp,
+		LastTimestamp:       in.DeprecatedLastTimestamp,
+		Count:               in.DeprecatedCount,
+		Type:                in.Type,
+		EventTime:           in.EventTime,
+		Action:              in.Action,
+		Related:             in.Related,
+		ReportingController: in.ReportingController,
+		ReportingInstance:   in.ReportingInstance,
+	}
+	if in.Series != nil {
+		out.Series = &coreV1.EventSeries{
+			Count:            in.Series.Count,
+			LastObservedTime: in.Series.LastObservedTime,
+		}
+	}
+
+	return out, nil
+}
+
 // Contains tells whether a contains x.
 func Contains(a []string, x string) bool {
 	for _, n := range a {
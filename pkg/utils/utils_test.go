@@ -2,9 +2,16 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	eventsV1 "k8s.io/api/events/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestGetClusterNameFromKubectlCmd(t *testing.T) {
@@ -118,3 +125,74 @@ func TestStructDump(t *testing.T) {
 		}`)
 	assert.Equal(t, expected, got)
 }
+
+func TestTransformIntoCoreEvent(t *testing.T) {
+	// given
+	lastObserved := metaV1.NewMicroTime(time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC).Local())
+	regarding := coreV1.ObjectReference{Kind: "Pod", APIVersion: "v1", Name: "api", Namespace: "team-a"}
+	related := &coreV1.ObjectReference{Kind: "Node", APIVersion: "v1", Name: "node-1"}
+
+	tests := []struct {
+		name     string
+		input    runtime.Object
+		expected coreV1.Event
+	}{
+		{
+			name: "events.k8s.io/v1 Event",
+			input: &eventsV1.Event{
+				TypeMeta:   metaV1.TypeMeta{APIVersion: "events.k8s.io/v1", Kind: "Event"},
+				ObjectMeta: metaV1.ObjectMeta{Name: "api.1", Namespace: "team-a"},
+				Regarding:  regarding,
+				Related:    related,
+				Note:       "Back-off restarting failed container",
+				Reason:     "BackOff",
+				Type:       "Warning",
+				Series:     &eventsV1.EventSeries{Count: 5, LastObservedTime: lastObserved},
+			},
+			expected: coreV1.Event{
+				TypeMeta:       metaV1.TypeMeta{APIVersion: "events.k8s.io/v1", Kind: "Event"},
+				ObjectMeta:     metaV1.ObjectMeta{Name: "api.1", Namespace: "team-a"},
+				InvolvedObject: regarding,
+				Related:        related,
+				Message:        "Back-off restarting failed container",
+				Reason:         "BackOff",
+				Type:           "Warning",
+				Series:         &coreV1.EventSeries{Count: 5, LastObservedTime: lastObserved},
+			},
+		},
+		{
+			name: "Core Event",
+			input: &coreV1.Event{
+				TypeMeta:       metaV1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+				ObjectMeta:     metaV1.ObjectMeta{Name: "api.1", Namespace: "team-a"},
+				InvolvedObject: regarding,
+				Message:        "Back-off restarting failed container",
+				Reason:         "BackOff",
+				Type:           "Warning",
+				Count:          3,
+			},
+			expected: coreV1.Event{
+				TypeMeta:       metaV1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+				ObjectMeta:     metaV1.ObjectMeta{Name: "api.1", Namespace: "team-a"},
+				InvolvedObject: regarding,
+				Message:        "Back-off restarting failed container",
+				Reason:         "BackOff",
+				Type:           "Warning",
+				Count:          3,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			unstr, err := runtime.DefaultUnstructuredConverter.ToUnstructured(tc.input)
+			require.NoError(t, err)
+
+			// when
+			actual, err := TransformIntoCoreEvent(&unstructured.Unstructured{Object: unstr})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
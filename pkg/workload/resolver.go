@@ -0,0 +1,203 @@
+package workload
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/kubeshop/botkube/pkg/utils"
+)
+
+// maxOwnerDepth limits the number of ownerReferences followed for a single object,
+// e.g. Pod -> ReplicaSet -> Deployment, or Pod -> Job -> CronJob.
+const maxOwnerDepth = 5
+
+const (
+	// ownerCacheTTL defines how long the controller references of fetched objects are cached.
+	// Objects such as ReplicaSets or Jobs are shared by many Pods, so their owners are fetched once for all events of those Pods.
+	ownerCacheTTL = time.Minute
+	// maxCachedObjects limits the number of objects with cached controller references.
+	maxCachedObjects = 5000
+)
+
+// Owner identifies the top-level workload which controls a given object.
+type Owner struct {
+	Kind string
+	Name string
+}
+
+// IsEmpty returns true if the object isn't controlled by any workload.
+func (o Owner) IsEmpty() bool {
+	return o.Kind == "" && o.Name == ""
+}
+
+// String returns the owner in the `Kind/name` format.
+func (o Owner) String() string {
+	if o.IsEmpty() {
+		return ""
+	}
+	return fmt.Sprintf("%s/%s", o.Kind, o.Name)
+}
+
+// Resolver resolves the top-level workload of Kubernetes objects by walking their ownerReferences.
+// The controller references of fetched objects are cached, so a single Resolver should be shared by all callers.
+type Resolver struct {
+	dynamicCli dynamic.Interface
+	mapper     meta.RESTMapper
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[objectKey]cachedController
+}
+
+type objectKey struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+// cachedController holds the controller reference of a fetched object.
+type cachedController struct {
+	ref       *metaV1.OwnerReference
+	found     bool
+	expiresAt time.Time
+}
+
+// NewResolver returns a new Resolver instance.
+func NewResolver(dynamicCli dynamic.Interface, mapper meta.RESTMapper) *Resolver {
+	return &Resolver{
+		dynamicCli: dynamicCli,
+		mapper:     mapper,
+		now:        time.Now,
+		cache:      map[objectKey]cachedController{},
+	}
+}
+
+// TopLevelOwner returns the top-level workload which controls a given object.
+// For the Kubernetes Event, the owner of the involved object is returned.
+// If the object isn't controlled by any other object, it returns empty Owner.
+func (r *Resolver) TopLevelOwner(ctx context.Context, obj interface{}) (Owner, error) {
+	unstrObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return Owner{}, fmt.Errorf("cannot convert type %T into *unstructured.Unstructured", obj)
+	}
+
+	ref, namespace := metaV1.GetControllerOfNoCopy(unstrObj), unstrObj.GetNamespace()
+	if unstrObj.GetKind() == "Event" {
+		eventObj, err := utils.TransformIntoCoreEvent(unstrObj)
+		if err != nil {
+			return Owner{}, fmt.Errorf("while transforming object type: %T into type: %T: %w", unstrObj, eventObj, err)
+		}
+
+		involved := eventObj.InvolvedObject
+		involvedRef, found, err := r.controllerOf(ctx, involved.GroupVersionKind(), involved.Namespace, involved.Name)
+		if err != nil {
+			return Owner{}, fmt.Errorf("while getting involved object %s/%s: %w", involved.Kind, involved.Name, err)
+		}
+		if !found {
+			return Owner{}, nil
+		}
+		ref, namespace = involvedRef, involved.Namespace
+	}
+
+	var owner Owner
+	for i := 0; i < maxOwnerDepth; i++ {
+		if ref == nil {
+			return owner, nil
+		}
+		owner = Owner{Kind: ref.Kind, Name: ref.Name}
+
+		next, found, err := r.controllerOf(ctx, schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind), namespace, ref.Name)
+		if err != nil {
+			return Owner{}, fmt.Errorf("while getting owner %s: %w", owner, err)
+		}
+		if !found {
+			// The owner is already gone, so it's the top-level one we know about.
+			return owner, nil
+		}
+		ref = next
+	}
+
+	return owner, nil
+}
+
+// controllerOf returns the controller reference of a given object. It returns false if the object doesn't exist.
+// Results are cached for the ownerCacheTTL period.
+func (r *Resolver) controllerOf(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string) (*metaV1.OwnerReference, bool, error) {
+	key := objectKey{gvk: gvk, namespace: namespace, name: name}
+	if cached, ok := r.getCached(key); ok {
+		return cached.ref, cached.found, nil
+	}
+
+	obj, err := r.get(ctx, gvk, namespace, name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	entry := cachedController{found: obj != nil}
+	if obj != nil {
+		if ref := metaV1.GetControllerOfNoCopy(obj); ref != nil {
+			refCopy := *ref
+			entry.ref = &refCopy
+		}
+	}
+	r.setCached(key, entry)
+
+	return entry.ref, entry.found, nil
+}
+
+func (r *Resolver) getCached(key objectKey) (cachedController, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cached, ok := r.cache[key]
+	if !ok || r.now().After(cached.expiresAt) {
+		return cachedController{}, false
+	}
+	return cached, true
+}
+
+func (r *Resolver) setCached(key objectKey, entry cachedController) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if len(r.cache) >= maxCachedObjects {
+		for k, cached := range r.cache {
+			if now.After(cached.expiresAt) {
+				delete(r.cache, k)
+			}
+		}
+	}
+	if len(r.cache) >= maxCachedObjects {
+		r.cache = map[objectKey]cachedController{}
+	}
+
+	entry.expiresAt = now.Add(ownerCacheTTL)
+	r.cache[key] = entry
+}
+
+// get returns a given object, or nil if it doesn't exist.
+func (r *Resolver) get(ctx context.Context, gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	gvr, err := utils.GetResourceFromKind(r.mapper, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := r.dynamicCli.Resource(gvr).Namespace(namespace).Get(ctx, name, metaV1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return obj, nil
+}
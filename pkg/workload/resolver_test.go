@@ -0,0 +1,191 @@
+package workload
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsV1 "k8s.io/api/apps/v1"
+	batchV1 "k8s.io/api/batch/v1"
+	coreV1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestResolver_TopLevelOwner(t *testing.T) {
+	// given
+	deploy := fixObject("apps/v1", "Deployment", "api", nil)
+	rs := fixObject("apps/v1", "ReplicaSet", "api-7d9f8", deploy)
+	cronJob := fixObject("batch/v1", "CronJob", "backup", nil)
+	job := fixObject("batch/v1", "Job", "backup-27781", cronJob)
+
+	tests := []struct {
+		name     string
+		obj      runtime.Object
+		expected Owner
+	}{
+		{
+			name:     "Pod owned by Deployment",
+			obj:      fixObject("v1", "Pod", "api-7d9f8-xk2lp", rs),
+			expected: Owner{Kind: "Deployment", Name: "api"},
+		},
+		{
+			name:     "Pod owned by CronJob",
+			obj:      fixObject("v1", "Pod", "backup-27781-abcde", job),
+			expected: Owner{Kind: "CronJob", Name: "backup"},
+		},
+		{
+			name:     "Pod owned by removed ReplicaSet",
+			obj:      fixObject("v1", "Pod", "api-5c4f7-qwert", fixObject("apps/v1", "ReplicaSet", "api-5c4f7", nil)),
+			expected: Owner{Kind: "ReplicaSet", Name: "api-5c4f7"},
+		},
+		{
+			name:     "Standalone Pod",
+			obj:      fixObject("v1", "Pod", "debug", nil),
+			expected: Owner{},
+		},
+		{
+			name:     "Event for Pod owned by Deployment",
+			obj:      fixEvent("api-7d9f8-xk2lp"),
+			expected: Owner{Kind: "Deployment", Name: "api"},
+		},
+		{
+			name:     "Event for removed Pod",
+			obj:      fixEvent("removed"),
+			expected: Owner{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme,
+				deploy, rs, cronJob, job,
+				fixObject("v1", "Pod", "api-7d9f8-xk2lp", rs),
+			)
+			resolver := NewResolver(dynamicCli, fixRESTMapper())
+
+			// when
+			owner, err := resolver.TopLevelOwner(context.Background(), toUnstructured(t, tc.obj))
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, owner)
+		})
+	}
+}
+
+func TestResolver_TopLevelOwnerCachesOwners(t *testing.T) {
+	// given
+	deploy := fixObject("apps/v1", "Deployment", "api", nil)
+	rs := fixObject("apps/v1", "ReplicaSet", "api-7d9f8", deploy)
+	dynamicCli := fake.NewSimpleDynamicClient(scheme.Scheme, deploy, rs)
+
+	now := time.Date(2022, 9, 1, 12, 0, 0, 0, time.UTC)
+	resolver := NewResolver(dynamicCli, fixRESTMapper())
+	resolver.now = func() time.Time { return now }
+
+	resolve := func(podName string) {
+		t.Helper()
+		owner, err := resolver.TopLevelOwner(context.Background(), toUnstructured(t, fixObject("v1", "Pod", podName, rs)))
+		require.NoError(t, err)
+		assert.Equal(t, Owner{Kind: "Deployment", Name: "api"}, owner)
+	}
+
+	// when
+	resolve("api-7d9f8-xk2lp")
+	resolve("api-7d9f8-qwert")
+
+	// then
+	assert.Len(t, dynamicCli.Actions(), 2, "owners of the second Pod should be served from cache")
+
+	// when
+	now = now.Add(ownerCacheTTL + time.Second)
+	resolve("api-7d9f8-xk2lp")
+
+	// then
+	assert.Len(t, dynamicCli.Actions(), 4, "expired owners should be fetched again")
+}
+
+func TestResolver_TopLevelOwnerUnknownKind(t *testing.T) {
+	// given
+	owner := fixObject("example.com/v1", "Custom", "custom", nil)
+	resolver := NewResolver(fake.NewSimpleDynamicClient(scheme.Scheme), fixRESTMapper())
+
+	// when
+	_, err := resolver.TopLevelOwner(context.Background(), toUnstructured(t, fixObject("v1", "Pod", "pod", owner)))
+
+	// then
+	assert.ErrorContains(t, err, "while getting owner Custom/custom")
+}
+
+func fixRESTMapper() meta.RESTMapper {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "ReplicaSet"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "CronJob"}, meta.RESTScopeNamespace)
+	return mapper
+}
+
+// fixObject returns a typed object of a given kind, optionally controlled by a given owner.
+func fixObject(apiVersion, kind, name string, owner runtime.Object) runtime.Object {
+	objMeta := metaV1.ObjectMeta{Name: name, Namespace: "team-a"}
+	if owner != nil {
+		ownerMeta := owner.(metaV1.Object)
+		objMeta.OwnerReferences = []metaV1.OwnerReference{
+			*metaV1.NewControllerRef(ownerMeta, owner.GetObjectKind().GroupVersionKind()),
+		}
+	}
+	typeMeta := metaV1.TypeMeta{APIVersion: apiVersion, Kind: kind}
+
+	switch kind {
+	case "Pod":
+		return &coreV1.Pod{TypeMeta: typeMeta, ObjectMeta: objMeta}
+	case "ReplicaSet":
+		return &appsV1.ReplicaSet{TypeMeta: typeMeta, ObjectMeta: objMeta}
+	case "Deployment":
+		return &appsV1.Deployment{TypeMeta: typeMeta, ObjectMeta: objMeta}
+	case "Job":
+		return &batchV1.Job{TypeMeta: typeMeta, ObjectMeta: objMeta}
+	case "CronJob":
+		return &batchV1.CronJob{TypeMeta: typeMeta, ObjectMeta: objMeta}
+	default:
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": "team-a",
+			},
+		}}
+	}
+}
+
+func fixEvent(podName string) runtime.Object {
+	return &coreV1.Event{
+		TypeMeta:   metaV1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+		ObjectMeta: metaV1.ObjectMeta{Name: podName + ".1", Namespace: "team-a"},
+		InvolvedObject: coreV1.ObjectReference{
+			APIVersion: "v1",
+			Kind:       "Pod",
+			Name:       podName,
+			Namespace:  "team-a",
+		},
+	}
+}
+
+func toUnstructured(t *testing.T, obj runtime.Object) *unstructured.Unstructured {
+	t.Helper()
+
+	out, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	require.NoError(t, err)
+	return &unstructured.Unstructured{Object: out}
+}